@@ -13,12 +13,17 @@ type Config struct {
 	serverAddress string
 	logger        LoggerConfig
 	neo4j         provider.Neo4J
+	graphQL       GraphQLConfig
 }
 
 var config *Config
 
 func Load() {
 	viper.SetDefault(envServerPort, "8080")
+	viper.SetDefault(envGraphQLMaxDepth, MaxDepth)
+	viper.SetDefault(envGraphQLMaxComplexity, MaxComplexity)
+	viper.SetDefault(envGraphQLMaxLimit, MaxLimit)
+	viper.SetDefault(envGraphQLMaxBodySize, MaxBodySize)
 
 	viper.AutomaticEnv()
 
@@ -47,6 +52,12 @@ func Load() {
 			LogEnabled:  getBool(envNeo4JLogEnabled),
 			LogLevel:    getInt(envNeo4JLogLevel),
 		},
+		graphQL: GraphQLConfig{
+			maxDepth:      getInt(envGraphQLMaxDepth),
+			maxComplexity: getInt(envGraphQLMaxComplexity),
+			maxLimit:      getInt(envGraphQLMaxLimit),
+			maxBodySize:   getInt(envGraphQLMaxBodySize),
+		},
 	}
 }
 
@@ -62,6 +73,10 @@ func Neo4J() provider.Neo4J {
 	return config.neo4j
 }
 
+func GraphQL() GraphQLConfig {
+	return config.graphQL
+}
+
 func getString(key string) string {
 	value, err := cookbook.StringEnv(key)
 	if err != nil {
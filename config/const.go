@@ -20,6 +20,17 @@ const (
 	envNeo4JLogEnabled  = "NEO4J_LOG_ENABLED"
 	envNeo4JLogLevel    = "NEO4J_LOG_LEVEL"
 
+	// GraphQL config
+	envGraphQLMaxDepth      = "GRAPHQL_MAX_DEPTH"
+	envGraphQLMaxComplexity = "GRAPHQL_MAX_COMPLEXITY"
+	envGraphQLMaxLimit      = "GRAPHQL_MAX_LIMIT"
+	envGraphQLMaxBodySize   = "GRAPHQL_MAX_BODY_SIZE"
+
 	Limit  = 25
 	Offset = 0
+
+	MaxDepth      = 10
+	MaxComplexity = 5000
+	MaxLimit      = 100
+	MaxBodySize   = 1 << 20
 )
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/dynastymasra/cookbook"
 )
@@ -33,6 +34,15 @@ func (s *ServiceError) Error() string {
 	return s.message
 }
 
+// Extensions is used as GraphQL error extensions, the code is derived from the HTTP status code
+func (s *ServiceError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code":   strings.ToUpper(strings.ReplaceAll(http.StatusText(s.code), " ", "_")),
+		"key":    s.key,
+		"status": s.code,
+	}
+}
+
 func ParseToJSON(err *ServiceError, w http.ResponseWriter, requestID string) {
 	if err.Code() >= 500 {
 		w.WriteHeader(err.Code())
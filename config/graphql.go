@@ -0,0 +1,29 @@
+package config
+
+// GraphQLConfig limits the GraphQL document before executed
+type GraphQLConfig struct {
+	maxDepth      int
+	maxComplexity int
+	maxLimit      int
+	maxBodySize   int
+}
+
+// MaxDepth is maximum nested fields of the document
+func (g GraphQLConfig) MaxDepth() int {
+	return g.maxDepth
+}
+
+// MaxComplexity is maximum cost of the document, list fields are weighted by the limit
+func (g GraphQLConfig) MaxComplexity() int {
+	return g.maxComplexity
+}
+
+// MaxLimit is maximum value of limit, first and last arguments
+func (g GraphQLConfig) MaxLimit() int {
+	return g.maxLimit
+}
+
+// MaxBodySize is maximum size of the request body in bytes
+func (g GraphQLConfig) MaxBodySize() int64 {
+	return int64(g.maxBodySize)
+}
@@ -0,0 +1,179 @@
+package console
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dynastymasra/cartographer/domain"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+
+	"github.com/neo4j/neo4j-go-driver/neo4j"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultBorderTolerance is the distance in degrees, about 11 meters, of the positions counted as the shared border,
+	// boundaries of the same dataset usually share the exact positions
+	DefaultBorderTolerance = 0.0001
+
+	// borderBatch is the number of rows written in one transaction
+	borderBatch = 1000
+)
+
+// BorderRow is one pair of the nodes sharing a border, code of the country is the ISO 3166 alpha-2
+type BorderRow struct {
+	Code      string
+	Neighbour string
+}
+
+// ReadBorders reads the pairs from the CSV file with the header code,neighbour, one pair is enough for both directions
+func ReadBorders(r io.Reader) ([]*BorderRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) < 1 || strings.ToLower(strings.Join(records[0], ",")) != "code,neighbour" {
+		return nil, fmt.Errorf("border file must start with the header code,neighbour")
+	}
+
+	rows := make([]*BorderRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		if record[0] == record[1] {
+			return nil, fmt.Errorf("line %d: code %q can't border itself", i+2, record[0])
+		}
+
+		rows = append(rows, &BorderRow{
+			Code:      record[0],
+			Neighbour: record[1],
+		})
+	}
+
+	return rows, nil
+}
+
+// DeriveBorders returns the pairs of the boundaries sharing a border within the tolerance in degrees,
+// only the boundaries with intersecting bounding boxes are compared
+func DeriveBorders(rows []*BoundaryRow, tolerance float64) ([]*BorderRow, error) {
+	geometries := make([]*domain.Geometry, len(rows))
+	entries := make([]*provider.RTreeEntry, 0, len(rows))
+	for i, row := range rows {
+		geometry, err := domain.ParseGeometry([]byte(row.Geometry))
+		if err != nil {
+			return nil, fmt.Errorf("boundary %s: %v", row.Code, err)
+		}
+		geometries[i] = geometry
+
+		bbox := geometry.Bounds()
+		entries = append(entries, &provider.RTreeEntry{
+			Box: provider.Box{
+				MinX: bbox[0] - tolerance,
+				MinY: bbox[1] - tolerance,
+				MaxX: bbox[2] + tolerance,
+				MaxY: bbox[3] + tolerance,
+			},
+			Value: i,
+		})
+	}
+
+	tree := provider.NewRTree(entries)
+
+	var borders []*BorderRow
+	for i, entry := range entries {
+		candidates := tree.SearchBox(entry.Box)
+		sort.Slice(candidates, func(a, b int) bool {
+			return candidates[a].(int) < candidates[b].(int)
+		})
+
+		for _, candidate := range candidates {
+			// every pair is compared once from the boundary with the lower index
+			j := candidate.(int)
+			if j <= i || rows[i].Code == rows[j].Code {
+				continue
+			}
+
+			if geometries[i].Touches(geometries[j], tolerance) {
+				borders = append(borders, &BorderRow{Code: rows[i].Code, Neighbour: rows[j].Code})
+			}
+		}
+	}
+
+	return borders, nil
+}
+
+// ImportBorders links the nodes of the level with the code to the neighbour with BORDERS, level is the node label
+// in any case, returns the number of matched pairs, pairs without matched nodes are skipped
+func ImportBorders(client neo4j.Driver, level string, rows []*BorderRow) (int, error) {
+	var node string
+	for _, label := range append([]string{domain.CountryNode}, domain.RegionNodes...) {
+		if strings.EqualFold(label, level) {
+			node = label
+		}
+	}
+	if len(node) < 1 {
+		return 0, fmt.Errorf("unknown level %q", level)
+	}
+
+	key := "code"
+	if node == domain.CountryNode {
+		key = "ISO3166Alpha2"
+	}
+
+	session, err := client.Session(neo4j.AccessModeWrite)
+	if err != nil {
+		logrus.WithError(err).Errorln("Failed create new session")
+		return 0, err
+	}
+	defer session.Close()
+
+	/**
+	UNWIND $rows AS row
+		MATCH (node:District{code: row.code}), (neighbour:District{code: row.neighbour})
+		MERGE (node)-[:BORDERS]-(neighbour)
+	RETURN count(node)
+	*/
+	statement := fmt.Sprintf(`UNWIND $rows AS row
+			MATCH (node:%s{%s: row.code}), (neighbour:%s{%s: row.neighbour})
+			MERGE (node)-[:%s]-(neighbour)
+			RETURN count(node)`, node, key, node, key, domain.BordersRelationship)
+
+	var total int
+	for start := 0; start < len(rows); start += borderBatch {
+		end := start + borderBatch
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		batch := make([]interface{}, 0, end-start)
+		for _, row := range rows[start:end] {
+			batch = append(batch, map[string]interface{}{
+				"code":      row.Code,
+				"neighbour": row.Neighbour,
+			})
+		}
+
+		count, err := session.WriteTransaction(func(tx neo4j.Transaction) (interface{}, error) {
+			record, err := neo4j.Single(tx.Run(statement, map[string]interface{}{"rows": batch}))
+			if err != nil {
+				return nil, err
+			}
+			return record.GetByIndex(0), nil
+		})
+		if err != nil {
+			logrus.WithField("node", node).WithError(err).Errorln("Failed import borders to storage")
+			return total, err
+		}
+
+		updated, _ := count.(int64)
+		total += int(updated)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,66 @@
+package console_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/console"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type BorderSuite struct {
+	suite.Suite
+}
+
+func Test_BorderSuite(t *testing.T) {
+	suite.Run(t, new(BorderSuite))
+}
+
+func (b *BorderSuite) Test_ReadBorders_Success() {
+	rows, err := console.ReadBorders(strings.NewReader("code,neighbour\nID,MY\nID, PG\n"))
+
+	assert.NoError(b.T(), err)
+	assert.Equal(b.T(), []*console.BorderRow{
+		{Code: "ID", Neighbour: "MY"},
+		{Code: "ID", Neighbour: "PG"},
+	}, rows)
+}
+
+func (b *BorderSuite) Test_ReadBorders_InvalidHeader() {
+	_, err := console.ReadBorders(strings.NewReader("code,border\nID,MY\n"))
+
+	assert.EqualError(b.T(), err, "border file must start with the header code,neighbour")
+}
+
+func (b *BorderSuite) Test_ReadBorders_Itself() {
+	_, err := console.ReadBorders(strings.NewReader("code,neighbour\nID,MY\nTL,TL\n"))
+
+	assert.EqualError(b.T(), err, `line 3: code "TL" can't border itself`)
+}
+
+func (b *BorderSuite) Test_DeriveBorders() {
+	rows := []*console.BoundaryRow{
+		{Code: "32.73.01", Geometry: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1],[0,0]]]}`},
+		{Code: "32.73.02", Geometry: `{"type":"Polygon","coordinates":[[[1,0],[2,0],[2,1],[1,1],[1,0]]]}`},
+		{Code: "32.73.03", Geometry: `{"type":"Polygon","coordinates":[[[2,1],[3,1],[3,2],[2,2],[2,1]]]}`},
+		{Code: "32.73.04", Geometry: `{"type":"Polygon","coordinates":[[[0,1],[2,1],[2,2],[0,2],[0,1]]]}`},
+	}
+
+	borders, err := console.DeriveBorders(rows, console.DefaultBorderTolerance)
+
+	assert.NoError(b.T(), err)
+	assert.Equal(b.T(), []*console.BorderRow{
+		{Code: "32.73.01", Neighbour: "32.73.02"},
+		{Code: "32.73.01", Neighbour: "32.73.04"},
+		{Code: "32.73.02", Neighbour: "32.73.04"},
+		{Code: "32.73.03", Neighbour: "32.73.04"},
+	}, borders)
+}
+
+func (b *BorderSuite) Test_DeriveBorders_InvalidGeometry() {
+	_, err := console.DeriveBorders([]*console.BoundaryRow{{Code: "32", Geometry: `{"type":"Point","coordinates":[0,0]}`}}, 0)
+
+	assert.EqualError(b.T(), err, `boundary 32: unsupported geometry type "Point"`)
+}
@@ -0,0 +1,149 @@
+package console
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/dynastymasra/cartographer/domain"
+
+	"github.com/neo4j/neo4j-go-driver/neo4j"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultCodeProperty is the feature property matched to the code of the node
+	DefaultCodeProperty = "code"
+
+	// boundaryBatch is smaller than the location batch, one geometry can be thousands of positions
+	boundaryBatch = 50
+)
+
+// BoundaryRow is one boundary of the import file, geometry is the compact GeoJSON geometry,
+// the bounding box is stored with the geometry to build the spatial index without reading the geometries
+type BoundaryRow struct {
+	Code     string
+	Geometry string
+	BBox     []float64
+}
+
+// ReadBoundaries reads the polygon and multi polygon features of the GeoJSON feature collection,
+// e.g. converted from a shapefile with ogr2ogr, the code is read from the feature property
+func ReadBoundaries(r io.Reader, property string) ([]*BoundaryRow, error) {
+	var collection struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Properties map[string]interface{} `json:"properties"`
+			Geometry   json.RawMessage        `json:"geometry"`
+		} `json:"features"`
+	}
+
+	if err := json.NewDecoder(r).Decode(&collection); err != nil {
+		return nil, err
+	}
+
+	if collection.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("boundary file must be a GeoJSON FeatureCollection")
+	}
+
+	rows := make([]*BoundaryRow, 0, len(collection.Features))
+	for i, feature := range collection.Features {
+		value, ok := feature.Properties[property]
+		if !ok || value == nil {
+			return nil, fmt.Errorf("feature %d: missing property %q", i, property)
+		}
+
+		code := fmt.Sprint(value)
+		if number, ok := value.(float64); ok {
+			code = strconv.FormatFloat(number, 'f', -1, 64)
+		}
+
+		geometry, err := domain.ParseGeometry(feature.Geometry)
+		if err != nil {
+			return nil, fmt.Errorf("feature %d: %v", i, err)
+		}
+
+		res, err := json.Marshal(geometry)
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, &BoundaryRow{
+			Code:     strings.TrimSpace(code),
+			Geometry: string(res),
+			BBox:     geometry.Bounds(),
+		})
+	}
+
+	return rows, nil
+}
+
+// ImportBoundaries replaces the boundary of the nodes of the level with the code, level is the node label in any case,
+// returns the number of updated nodes, rows without matched node are skipped
+func ImportBoundaries(client neo4j.Driver, level string, rows []*BoundaryRow) (int, error) {
+	var node string
+	for _, region := range domain.RegionNodes {
+		if strings.EqualFold(region, level) {
+			node = region
+		}
+	}
+	if len(node) < 1 {
+		return 0, fmt.Errorf("unknown level %q", level)
+	}
+
+	session, err := client.Session(neo4j.AccessModeWrite)
+	if err != nil {
+		logrus.WithError(err).Errorln("Failed create new session")
+		return 0, err
+	}
+	defer session.Close()
+
+	/**
+	UNWIND $rows AS row
+		MATCH (node:District{code: row.code})
+		MERGE (node)-[:BOUNDARY]->(boundary:Boundary)
+		SET boundary.geometry = row.geometry, boundary.bbox = row.bbox
+	RETURN count(node)
+	*/
+	statement := fmt.Sprintf(`UNWIND $rows AS row
+			MATCH (node:%s{code: row.code})
+			MERGE (node)-[:BOUNDARY]->(boundary:%s)
+			SET boundary.geometry = row.geometry, boundary.bbox = row.bbox
+			RETURN count(node)`, node, domain.BoundaryNode)
+
+	var total int
+	for start := 0; start < len(rows); start += boundaryBatch {
+		end := start + boundaryBatch
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		batch := make([]interface{}, 0, end-start)
+		for _, row := range rows[start:end] {
+			batch = append(batch, map[string]interface{}{
+				"code":     row.Code,
+				"geometry": row.Geometry,
+				"bbox":     row.BBox,
+			})
+		}
+
+		count, err := session.WriteTransaction(func(tx neo4j.Transaction) (interface{}, error) {
+			record, err := neo4j.Single(tx.Run(statement, map[string]interface{}{"rows": batch}))
+			if err != nil {
+				return nil, err
+			}
+			return record.GetByIndex(0), nil
+		})
+		if err != nil {
+			logrus.WithField("node", node).WithError(err).Errorln("Failed import boundaries to storage")
+			return total, err
+		}
+
+		updated, _ := count.(int64)
+		total += int(updated)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,47 @@
+package console_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/console"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type BoundarySuite struct {
+	suite.Suite
+}
+
+func Test_BoundarySuite(t *testing.T) {
+	suite.Run(t, new(BoundarySuite))
+}
+
+func (b *BoundarySuite) Test_ReadBoundaries_Success() {
+	rows, err := console.ReadBoundaries(strings.NewReader(`{"type":"FeatureCollection","features":[
+		{"type":"Feature","properties":{"KDCPUM":"32.73.02","NAME":"Coblong"},
+			"geometry":{"type":"Polygon","coordinates":[[[107.6,-6.9],[107.7,-6.9],[107.7,-6.8],[107.6,-6.9]]]}}]}`), "KDCPUM")
+
+	assert.NoError(b.T(), err)
+	assert.Equal(b.T(), []*console.BoundaryRow{{
+		Code:     "32.73.02",
+		Geometry: `{"coordinates":[[[107.6,-6.9],[107.7,-6.9],[107.7,-6.8],[107.6,-6.9]]],"type":"Polygon"}`,
+		BBox:     []float64{107.6, -6.9, 107.7, -6.8},
+	}}, rows)
+}
+
+func (b *BoundarySuite) Test_ReadBoundaries_MissingCode() {
+	_, err := console.ReadBoundaries(strings.NewReader(`{"type":"FeatureCollection","features":[
+		{"type":"Feature","properties":{"NAME":"Coblong"},
+			"geometry":{"type":"Polygon","coordinates":[[[107.6,-6.9],[107.7,-6.9],[107.7,-6.8],[107.6,-6.9]]]}}]}`), "code")
+
+	assert.EqualError(b.T(), err, `feature 0: missing property "code"`)
+}
+
+func (b *BoundarySuite) Test_ReadBoundaries_Point() {
+	_, err := console.ReadBoundaries(strings.NewReader(`{"type":"FeatureCollection","features":[
+		{"type":"Feature","properties":{"code":"32"},"geometry":{"type":"Point","coordinates":[107.6,-6.9]}}]}`), "code")
+
+	assert.EqualError(b.T(), err, `feature 0: unsupported geometry type "Point"`)
+}
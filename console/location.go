@@ -0,0 +1,144 @@
+package console
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/dynastymasra/cartographer/domain"
+
+	"github.com/neo4j/neo4j-go-driver/neo4j"
+	"github.com/sirupsen/logrus"
+)
+
+// locationBatch is the number of rows written in one transaction
+const locationBatch = 1000
+
+// LocationRow is one centroid of the import file, code of the country is the ISO 3166 alpha-2
+type LocationRow struct {
+	Node      string
+	Code      string
+	Latitude  float64
+	Longitude float64
+}
+
+// ReadLocations reads the centroids from the CSV file with the header level,code,latitude,longitude,
+// level is the node label in any case, e.g. village or Village
+func ReadLocations(r io.Reader) ([]*LocationRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) < 1 || strings.ToLower(strings.Join(records[0], ",")) != "level,code,latitude,longitude" {
+		return nil, fmt.Errorf("location file must start with the header level,code,latitude,longitude")
+	}
+
+	nodes := map[string]string{strings.ToLower(domain.CountryNode): domain.CountryNode}
+	for _, node := range domain.RegionNodes {
+		nodes[strings.ToLower(node)] = node
+	}
+
+	rows := make([]*LocationRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		line := i + 2
+
+		node, ok := nodes[strings.ToLower(record[0])]
+		if !ok {
+			return nil, fmt.Errorf("line %d: unknown level %q", line, record[0])
+		}
+
+		latitude, err := strconv.ParseFloat(record[2], 64)
+		if err != nil || latitude < -90 || latitude > 90 {
+			return nil, fmt.Errorf("line %d: invalid latitude %q", line, record[2])
+		}
+
+		longitude, err := strconv.ParseFloat(record[3], 64)
+		if err != nil || longitude < -180 || longitude > 180 {
+			return nil, fmt.Errorf("line %d: invalid longitude %q", line, record[3])
+		}
+
+		rows = append(rows, &LocationRow{
+			Node:      node,
+			Code:      record[1],
+			Latitude:  latitude,
+			Longitude: longitude,
+		})
+	}
+
+	return rows, nil
+}
+
+// ImportLocations writes the centroids as WGS-84 point to the nodes with the code,
+// returns the number of updated nodes, rows without matched node are skipped
+func ImportLocations(client neo4j.Driver, rows []*LocationRow) (int, error) {
+	session, err := client.Session(neo4j.AccessModeWrite)
+	if err != nil {
+		logrus.WithError(err).Errorln("Failed create new session")
+		return 0, err
+	}
+	defer session.Close()
+
+	groups := make(map[string][]interface{})
+	var nodes []string
+	for _, row := range rows {
+		if _, ok := groups[row.Node]; !ok {
+			nodes = append(nodes, row.Node)
+		}
+		groups[row.Node] = append(groups[row.Node], map[string]interface{}{
+			"code":      row.Code,
+			"latitude":  row.Latitude,
+			"longitude": row.Longitude,
+		})
+	}
+
+	var total int
+	for _, node := range nodes {
+		key := "code"
+		if node == domain.CountryNode {
+			key = "ISO3166Alpha2"
+		}
+
+		/**
+		UNWIND $rows AS row
+			MATCH (node:Village{code: row.code})
+			SET node.location = point({latitude: row.latitude, longitude: row.longitude})
+		RETURN count(node)
+		*/
+		statement := fmt.Sprintf(`UNWIND $rows AS row
+			MATCH (node:%s{%s: row.code})
+			SET node.location = point({latitude: row.latitude, longitude: row.longitude})
+			RETURN count(node)`, node, key)
+
+		group := groups[node]
+		for start := 0; start < len(group); start += locationBatch {
+			end := start + locationBatch
+			if end > len(group) {
+				end = len(group)
+			}
+
+			count, err := session.WriteTransaction(func(tx neo4j.Transaction) (interface{}, error) {
+				record, err := neo4j.Single(tx.Run(statement, map[string]interface{}{"rows": group[start:end]}))
+				if err != nil {
+					return nil, err
+				}
+				return record.GetByIndex(0), nil
+			})
+			if err != nil {
+				logrus.WithField("node", node).WithError(err).Errorln("Failed import locations to storage")
+				return total, err
+			}
+
+			updated, _ := count.(int64)
+			total += int(updated)
+		}
+	}
+
+	return total, nil
+}
@@ -0,0 +1,50 @@
+package console_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/console"
+	"github.com/dynastymasra/cartographer/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocationSuite struct {
+	suite.Suite
+}
+
+func Test_LocationSuite(t *testing.T) {
+	suite.Run(t, new(LocationSuite))
+}
+
+func (l *LocationSuite) Test_ReadLocations_Success() {
+	rows, err := console.ReadLocations(strings.NewReader("level,code,latitude,longitude\n" +
+		"country,ID,-2.548926,118.014863\n" +
+		"Village, 32.73.02.1001, -6.884, 107.613\n"))
+
+	assert.NoError(l.T(), err)
+	assert.Equal(l.T(), []*console.LocationRow{
+		{Node: domain.CountryNode, Code: "ID", Latitude: -2.548926, Longitude: 118.014863},
+		{Node: domain.VillageNode, Code: "32.73.02.1001", Latitude: -6.884, Longitude: 107.613},
+	}, rows)
+}
+
+func (l *LocationSuite) Test_ReadLocations_Header() {
+	_, err := console.ReadLocations(strings.NewReader("code,latitude,longitude,level\n"))
+
+	assert.Error(l.T(), err)
+}
+
+func (l *LocationSuite) Test_ReadLocations_UnknownLevel() {
+	_, err := console.ReadLocations(strings.NewReader("level,code,latitude,longitude\nstate,32,-7.09,107.66\n"))
+
+	assert.EqualError(l.T(), err, `line 2: unknown level "state"`)
+}
+
+func (l *LocationSuite) Test_ReadLocations_InvalidCoordinate() {
+	_, err := console.ReadLocations(strings.NewReader("level,code,latitude,longitude\nprovince,32,107.66,-7.09\n"))
+
+	assert.EqualError(l.T(), err, `line 2: invalid latitude "107.66"`)
+}
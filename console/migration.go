@@ -7,6 +7,8 @@ import (
 	"os"
 	"time"
 
+	"github.com/dynastymasra/cartographer/domain"
+
 	j "github.com/neo4j/neo4j-go-driver/neo4j"
 
 	"github.com/sirupsen/logrus"
@@ -78,6 +80,68 @@ func RunMigration(migration *migrate.Migrate) error {
 	return nil
 }
 
+// SyncFullTextIndex recreates the full-text index of the administrative division names when the labels of the index
+// aren't the configured levels, the migration creates the index with the levels of the default hierarchy
+func SyncFullTextIndex(client j.Driver) error {
+	session, err := client.Session(j.AccessModeWrite)
+	if err != nil {
+		logrus.WithError(err).Errorln("Failed create new session")
+		return err
+	}
+	defer session.Close()
+
+	records, err := j.Collect(session.Run(`CALL db.indexes() YIELD name, labelsOrTypes WHERE name = $name RETURN labelsOrTypes`,
+		map[string]interface{}{"name": domain.RegionFullTextIndex}))
+	if err != nil {
+		logrus.WithError(err).Errorln("Failed read full-text index from storage")
+		return err
+	}
+
+	if len(records) > 0 {
+		labels, _ := records[0].GetByIndex(0).([]interface{})
+		if sameLabels(labels, domain.RegionNodes) {
+			return nil
+		}
+
+		if _, err := j.Collect(session.Run(`CALL db.index.fulltext.drop($name)`,
+			map[string]interface{}{"name": domain.RegionFullTextIndex})); err != nil {
+			logrus.WithError(err).Errorln("Failed drop full-text index")
+			return err
+		}
+	}
+
+	if _, err := j.Collect(session.Run(`CALL db.index.fulltext.createNodeIndex($name, $labels, ["name"])`,
+		map[string]interface{}{"name": domain.RegionFullTextIndex, "labels": domain.RegionNodes})); err != nil {
+		logrus.WithError(err).Errorln("Failed create full-text index")
+		return err
+	}
+
+	logrus.WithField("labels", domain.RegionNodes).Infoln("Success recreate full-text index of the levels")
+
+	return nil
+}
+
+func sameLabels(indexed []interface{}, labels []string) bool {
+	if len(indexed) != len(labels) {
+		return false
+	}
+
+	for _, label := range labels {
+		var found bool
+		for _, value := range indexed {
+			if value == label {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
 func RollbackMigration(migration *migrate.Migrate) error {
 	if err := migration.Steps(-1); err != nil {
 		logrus.WithError(err).Errorln("Failed rollback database migration")
@@ -0,0 +1,193 @@
+package console
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+)
+
+// builtInScalars are defined by the GraphQL specification and not printed
+var builtInScalars = map[string]bool{
+	"String":  true,
+	"Int":     true,
+	"Float":   true,
+	"Boolean": true,
+	"ID":      true,
+}
+
+// PrintSchemaFile writes the schema SDL to the file, the SDL is printed to stdout when filename is empty
+func PrintSchemaFile(schema graphql.Schema, filename string) error {
+	sdl := PrintSchema(schema)
+
+	if len(filename) == 0 {
+		_, err := fmt.Fprint(os.Stdout, sdl)
+		return err
+	}
+
+	return ioutil.WriteFile(filename, []byte(sdl), 0644)
+}
+
+// PrintSchema prints the schema as GraphQL SDL, types, fields and arguments are sorted by name
+func PrintSchema(schema graphql.Schema) string {
+	names := make([]string, 0, len(schema.TypeMap()))
+	for name := range schema.TypeMap() {
+		if strings.HasPrefix(name, "__") || builtInScalars[name] {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	definitions := make([]string, 0, len(names))
+	for _, name := range names {
+		switch t := schema.TypeMap()[name].(type) {
+		case *graphql.Scalar:
+			definitions = append(definitions, printDescription(t.Description(), "")+"scalar "+t.Name())
+		case *graphql.Enum:
+			definitions = append(definitions, printEnum(t))
+		case *graphql.InputObject:
+			definitions = append(definitions, printInputObject(t))
+		case *graphql.Interface:
+			definitions = append(definitions, printDescription(t.Description(), "")+
+				fmt.Sprintf("interface %s %s", t.Name(), printFields(t.Fields())))
+		case *graphql.Union:
+			definitions = append(definitions, printUnion(t))
+		case *graphql.Object:
+			definitions = append(definitions, printObject(t))
+		}
+	}
+
+	return strings.Join(definitions, "\n\n") + "\n"
+}
+
+func printObject(object *graphql.Object) string {
+	var implements string
+	if len(object.Interfaces()) > 0 {
+		interfaces := make([]string, 0, len(object.Interfaces()))
+		for _, i := range object.Interfaces() {
+			interfaces = append(interfaces, i.Name())
+		}
+		sort.Strings(interfaces)
+		implements = " implements " + strings.Join(interfaces, " & ")
+	}
+
+	// Object.Description always returns empty string
+	return printDescription(object.PrivateDescription, "") +
+		fmt.Sprintf("type %s%s %s", object.Name(), implements, printFields(object.Fields()))
+}
+
+func printUnion(union *graphql.Union) string {
+	types := make([]string, 0, len(union.Types()))
+	for _, t := range union.Types() {
+		types = append(types, t.Name())
+	}
+	sort.Strings(types)
+
+	return printDescription(union.Description(), "") +
+		fmt.Sprintf("union %s = %s", union.Name(), strings.Join(types, " | "))
+}
+
+func printEnum(enum *graphql.Enum) string {
+	values := make([]string, 0, len(enum.Values()))
+	for _, value := range enum.Values() {
+		values = append(values, printDescription(value.Description, "  ")+"  "+value.Name)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return strings.TrimSpace(values[i]) < strings.TrimSpace(values[j])
+	})
+
+	return printDescription(enum.Description(), "") +
+		fmt.Sprintf("enum %s {\n%s\n}", enum.Name(), strings.Join(values, "\n"))
+}
+
+func printInputObject(input *graphql.InputObject) string {
+	fields := input.Fields()
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		field := fields[name]
+		lines = append(lines, printDescription(field.Description(), "  ")+
+			fmt.Sprintf("  %s: %s%s", name, field.Type.String(), printDefault(field.DefaultValue)))
+	}
+
+	return printDescription(input.Description(), "") +
+		fmt.Sprintf("input %s {\n%s\n}", input.Name(), strings.Join(lines, "\n"))
+}
+
+func printFields(fields graphql.FieldDefinitionMap) string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		field := fields[name]
+		lines = append(lines, printDescription(field.Description, "  ")+
+			fmt.Sprintf("  %s%s: %s", name, printArgs(field.Args), field.Type.String()))
+	}
+
+	return fmt.Sprintf("{\n%s\n}", strings.Join(lines, "\n"))
+}
+
+func printArgs(args []*graphql.Argument) string {
+	if len(args) < 1 {
+		return ""
+	}
+
+	sorted := make([]*graphql.Argument, len(args))
+	copy(sorted, args)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name() < sorted[j].Name()
+	})
+
+	described := false
+	lines := make([]string, 0, len(sorted))
+	for _, arg := range sorted {
+		described = described || len(arg.Description()) > 0
+		lines = append(lines, fmt.Sprintf("%s: %s%s", arg.Name(), arg.Type.String(), printDefault(arg.DefaultValue)))
+	}
+
+	if !described {
+		return fmt.Sprintf("(%s)", strings.Join(lines, ", "))
+	}
+
+	for i, arg := range sorted {
+		lines[i] = printDescription(arg.Description(), "    ") + "    " + lines[i]
+	}
+
+	return fmt.Sprintf("(\n%s\n  )", strings.Join(lines, "\n"))
+}
+
+func printDescription(description, indent string) string {
+	if len(description) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s\"\"\"%s\"\"\"\n", indent, strings.ReplaceAll(description, `"""`, `\"""`))
+}
+
+func printDefault(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	switch v := value.(type) {
+	case string:
+		return " = " + strconv.Quote(v)
+	default:
+		return fmt.Sprintf(" = %v", v)
+	}
+}
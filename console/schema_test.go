@@ -0,0 +1,66 @@
+package console_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/config"
+	"github.com/dynastymasra/cartographer/console"
+	"github.com/dynastymasra/cartographer/infrastructure/web"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const schemaFile = "../schema.graphql"
+
+var update = flag.Bool("update", false, "update the committed schema.graphql")
+
+type SchemaSuite struct {
+	suite.Suite
+}
+
+func Test_SchemaSuite(t *testing.T) {
+	suite.Run(t, new(SchemaSuite))
+}
+
+func (s *SchemaSuite) SetupSuite() {
+	config.SetupTestLogger()
+}
+
+func (s *SchemaSuite) Test_PrintSchema_Drift() {
+	schema, err := web.BuildSchema(nil, nil)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	sdl := console.PrintSchema(schema)
+
+	if *update {
+		if err := console.PrintSchemaFile(schema, schemaFile); err != nil {
+			s.T().Fatal(err)
+		}
+	}
+
+	committed, err := ioutil.ReadFile(schemaFile)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	assert.Equal(s.T(), string(committed), sdl, "schema.graphql is out of date, run go test ./console -update")
+}
+
+func (s *SchemaSuite) Test_PrintSchema_Deterministic() {
+	first, err := web.BuildSchema(nil, nil)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	second, err := web.BuildSchema(nil, nil)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	assert.Equal(s.T(), console.PrintSchema(first), console.PrintSchema(second))
+}
@@ -1,7 +1,7 @@
 package handler
 
 import (
-	"fmt"
+	"context"
 	"net/http"
 	"reflect"
 	"runtime"
@@ -11,160 +11,198 @@ import (
 	"github.com/dynastymasra/cartographer/country"
 	"github.com/dynastymasra/cartographer/domain"
 	"github.com/dynastymasra/cartographer/infrastructure/provider"
+	webHandler "github.com/dynastymasra/cartographer/infrastructure/web/handler"
 	"github.com/dynastymasra/cookbook"
 	"github.com/graphql-go/graphql"
-	"github.com/graphql-go/graphql/gqlerrors"
-	graph "github.com/graphql-go/handler"
 	"github.com/sirupsen/logrus"
 )
 
+// FindCountry is kept for the old endpoint, it serves the same as the unified GraphQL endpoint
 func FindCountry(schema graphql.Schema) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
+	return webHandler.GraphQL(schema)
+}
 
-		requestID := r.Context().Value(cookbook.RequestID).(string)
-		log := logrus.WithFields(logrus.Fields{
-			cookbook.RequestID: requestID,
-			"package":          runtime.FuncForPC(reflect.ValueOf(FindCountry).Pointer()).Name(),
+func CountryQuery(repo country.Repository) *graphql.Object {
+	return graphql.NewObject(
+		graphql.ObjectConfig{
+			Name:        "Query",
+			Description: "Query country from storage",
+			Fields:      CountryFields(repo),
 		})
+}
 
-		req := graph.NewRequestOptions(r)
-		result := graphql.Do(graphql.Params{
-			Schema:         schema,
-			RequestString:  req.Query,
-			VariableValues: req.Variables,
-			OperationName:  req.OperationName,
-			Context:        r.Context(),
-		})
+// CountryFields returns root query fields of country, used by the module query and the unified query
+func CountryFields(repo country.Repository) graphql.Fields {
+	return graphql.Fields{
+		"country": &graphql.Field{
+			Type: domain.CountryType,
+			Args: domain.CountryArgs,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				log := logrus.WithFields(logrus.Fields{
+					cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+					"package":          runtime.FuncForPC(reflect.ValueOf(CountryFields).Pointer()).Name(),
+					"arguments":        cookbook.Stringify(p.Args),
+				})
+
+				query := provider.NewQuery(domain.CountryNode)
+
+				for key, field := range p.Args {
+					query.Filter(key, provider.Equal, field)
+				}
 
-		if len(result.Errors) > 0 {
-			var errs []cookbook.JSON
-			for _, err := range result.Errors {
-				log.WithError(err).Warnln("Failed process request")
+				if len(query.Filters) < 1 {
+					log.WithField("query", cookbook.Stringify(query)).Warnln("Query is empty")
+					return nil, config.NewError(http.StatusPreconditionFailed, strings.ToLower(query.Node), "need min one argument")
+				}
 
-				switch err := err.OriginalError().(type) {
-				case *gqlerrors.Error:
-					if err, ok := err.OriginalError.(*config.ServiceError); ok {
-						if err.Code() >= http.StatusInternalServerError {
-							log.WithError(err).Errorln("Failed process data from storage")
-						}
+				res, err := repo.Find(p.Context, query)
+				if err != nil {
+					if err.Error() == provider.ErrorRecordNotFound {
+						return nil, config.NewError(http.StatusNotFound, strings.ToLower(query.Node), err.Error())
+					}
 
-						config.ParseToJSON(err, w, requestID)
-						return
+					if err.Error() == provider.ErrorRecordMoreThanOne {
+						return nil, config.NewError(http.StatusPreconditionFailed, strings.ToLower(query.Node), err.Error())
 					}
-					errs = append(errs, cookbook.JSON{
-						"message": err.Error(),
-					})
-				default:
-					errs = append(errs, cookbook.JSON{
-						"message": err.Error(),
-					})
+
+					return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find country from storage")
 				}
-			}
 
-			w.WriteHeader(http.StatusBadRequest)
-			fmt.Fprint(w, cookbook.FailResponse(&cookbook.JSON{"errors": errs}, requestID).Stringify())
-			return
-		}
+				return res, nil
+			},
+		},
+		"countries": &graphql.Field{
+			Type: graphql.NewList(domain.CountryType),
+			Args: domain.ListCountryArgs,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				log := logrus.WithFields(logrus.Fields{
+					cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+					"package":          runtime.FuncForPC(reflect.ValueOf(CountryFields).Pointer()).Name(),
+					"arguments":        cookbook.Stringify(p.Args),
+				})
+
+				offset, limit, err := domain.SliceArgs(p.Args)
+				if err != nil {
+					return nil, err
+				}
 
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, cookbook.SuccessDataResponse(result.Data, nil).Stringify())
-	}
-}
+				query := listQuery(p.Args)
+				query.Slice(offset, limit)
 
-func CountryQuery(repo country.Repository) *graphql.Object {
-	return graphql.NewObject(
-		graphql.ObjectConfig{
-			Name:        "Query",
-			Description: "Query country from storage",
-			Fields: graphql.Fields{
-				"country": &graphql.Field{
-					Type: domain.CountryType,
-					Args: domain.CountryArgs,
-					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-						log := logrus.WithFields(logrus.Fields{
-							cookbook.RequestID: p.Context.Value(cookbook.RequestID),
-							"package":          runtime.FuncForPC(reflect.ValueOf(CountryQuery).Pointer()).Name(),
-							"arguments":        cookbook.Stringify(p.Args),
-						})
-
-						query := provider.NewQuery(domain.CountryNode)
-
-						for key, field := range p.Args {
-							query.Filter(key, provider.Equal, field)
-						}
+				results, err := repo.FindAll(p.Context, query)
+				if err != nil {
+					return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find country from storage")
+				}
 
-						if len(query.Filters) < 1 {
-							log.WithField("query", cookbook.Stringify(query)).Warnln("Query is empty")
-							return nil, config.NewError(http.StatusPreconditionFailed, strings.ToLower(query.Node), "need min one argument")
-						}
+				return results, nil
+			},
+		},
+		"countriesCount": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+			Args: domain.CountCountryArgs,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				log := logrus.WithFields(logrus.Fields{
+					cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+					"package":          runtime.FuncForPC(reflect.ValueOf(CountryFields).Pointer()).Name(),
+					"arguments":        cookbook.Stringify(p.Args),
+				})
+
+				query := listQuery(p.Args)
+
+				total, err := repo.Count(p.Context, query)
+				if err != nil {
+					return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed count country from storage")
+				}
 
-						res, err := repo.Find(p.Context, query)
-						if err != nil {
-							if err.Error() == provider.ErrorRecordNotFound {
-								return nil, config.NewError(http.StatusNotFound, strings.ToLower(query.Node), err.Error())
-							}
+				return total, nil
+			},
+		},
+		"countriesConnection": &graphql.Field{
+			Type: domain.CountryConnectionType,
+			Args: domain.ConnectionCountryArgs,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				log := logrus.WithFields(logrus.Fields{
+					cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+					"package":          runtime.FuncForPC(reflect.ValueOf(CountryFields).Pointer()).Name(),
+					"arguments":        cookbook.Stringify(p.Args),
+				})
+
+				page, err := domain.NewPage(p.Args)
+				if err != nil {
+					return nil, err
+				}
 
-							if err.Error() == provider.ErrorRecordMoreThanOne {
-								return nil, config.NewError(http.StatusPreconditionFailed, strings.ToLower(query.Node), err.Error())
-							}
+				// Order by id after the ordered fields, cursor must point to an unique position
+				query := listQuery(p.Args)
+				query.Ordering("id", provider.Ascending)
+				count := query.Copy()
 
-							log.WithField("query", cookbook.Stringify(query)).WithError(err).Errorln("Failed find country from storage")
-							return nil, config.NewError(http.StatusInternalServerError, "", err.Error())
-						}
+				if err := page.Apply(query); err != nil {
+					return nil, err
+				}
 
-						return res, nil
-					},
-				},
-				"countries": &graphql.Field{
-					Type: graphql.NewList(domain.CountryType),
-					Args: domain.ListCountryArgs,
-					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-						log := logrus.WithFields(logrus.Fields{
-							cookbook.RequestID: p.Context.Value(cookbook.RequestID),
-							"package":          runtime.FuncForPC(reflect.ValueOf(CountryQuery).Pointer()).Name(),
-							"arguments":        cookbook.Stringify(p.Args),
-						})
-
-						limit := p.Args["limit"].(int)
-						offset := p.Args["offset"].(int)
-
-						// Delete value from map avoid duplicate value
-						delete(p.Args, "limit")
-						delete(p.Args, "offset")
-
-						query := provider.NewQuery(domain.CountryNode)
-						query.Slice(offset, limit)
-						query.Ordering("name", provider.Ascending)
-
-						for key, field := range p.Args {
-							switch val := field.(type) {
-							case []interface{}:
-								if node, ok := domain.Outgoing[key]; ok {
-									q := provider.NewQuery(node)
-									for _, v := range val {
-										if va, ok := v.(map[string]interface{}); ok {
-											for k, v := range va {
-												q.Filter(k, provider.In, v)
-											}
-										}
-									}
-									query.Outgoing(q)
-								}
-							default:
-								query.Filter(key, provider.Equal, field)
-							}
-						}
+				results, err := repo.FindAll(p.Context, query)
+				if err != nil {
+					return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find country from storage")
+				}
 
-						results, err := repo.FindAll(p.Context, query)
-						if err != nil {
-							log.WithField("query", cookbook.Stringify(query)).WithError(err).Errorln("Failed find country from storage")
-							return nil, config.NewError(http.StatusInternalServerError, "", err.Error())
-						}
+				nodes := make([]interface{}, 0, len(results))
+				for _, result := range results {
+					nodes = append(nodes, result)
+				}
 
-						return results, nil
-					},
-				},
+				connection, err := page.Connection(nodes, count.Orderings)
+				if err != nil {
+					log.WithError(err).Errorln("Failed create country connection")
+					return nil, config.NewError(http.StatusInternalServerError, "", err.Error())
+				}
+
+				connection.TotalCount = func(ctx context.Context) (int, error) {
+					total, err := repo.Count(ctx, count)
+					if err != nil {
+						return 0, domain.StorageError(log.WithField("query", cookbook.Stringify(count)), err, "Failed count country from storage")
+					}
+
+					return total, nil
+				}
+
+				return connection, nil
 			},
-		})
+		},
+	}
+}
+
+// listQuery creates country query ordered by the orderBy argument or name from list arguments, pagination arguments are ignored
+func listQuery(args map[string]interface{}) *provider.Query {
+	query := provider.NewQuery(domain.CountryNode)
+	domain.Order(query, args["orderBy"])
+
+	for key, field := range args {
+		switch key {
+		case "limit", "offset", "first", "after", "last", "before", "where", "orderBy":
+			continue
+		}
+
+		switch val := field.(type) {
+		case []interface{}:
+			if node, ok := domain.Outgoing[key]; ok {
+				q := provider.NewQuery(node)
+				for _, v := range val {
+					if va, ok := v.(map[string]interface{}); ok {
+						for k, v := range va {
+							q.Filter(k, provider.In, v)
+						}
+					}
+				}
+				query.Outgoing(q)
+			}
+		default:
+			query.Filter(key, provider.Equal, field)
+		}
+	}
+
+	where, _ := args["where"].(map[string]interface{})
+	query.Where(where, nil, domain.Outgoing)
+
+	return query
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -12,14 +13,18 @@ import (
 	graph "github.com/graphql-go/handler"
 
 	"github.com/dynastymasra/cartographer/config"
+	"github.com/dynastymasra/cartographer/country"
 	"github.com/dynastymasra/cartographer/country/handler"
 	"github.com/dynastymasra/cartographer/country/test"
 	"github.com/dynastymasra/cartographer/domain"
 	"github.com/dynastymasra/cartographer/infrastructure/provider"
+	"github.com/dynastymasra/cartographer/region"
+	regionTest "github.com/dynastymasra/cartographer/region/test"
 	"github.com/dynastymasra/cookbook"
 	"github.com/graphql-go/graphql"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -262,6 +267,121 @@ func (c *CountrySuite) Test_FindListCountry_Success() {
 	assert.Equal(c.T(), http.StatusOK, w.Code)
 }
 
+func (c *CountrySuite) Test_FindListCountry_OrderBy() {
+	body := []byte(`{"query":"{countries(orderBy: [{field: DIAL_CODE}, {field: ISO3166_ALPHA2, direction: DESC}]) {id name dialCode}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/countries", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.CountryQuery(c.repo),
+	})
+	if err != nil {
+		c.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("Country")
+	query.Slice(config.Offset, config.Limit)
+	query.Ordering("dialCode", provider.Ascending)
+	query.Ordering("ISO3166Alpha2", provider.Descending)
+
+	res := []*domain.Country{
+		{ID: uuid.NewV4().String(), Name: "United States", ISO3166Alpha2: "US", CallingCode: "1"},
+		{ID: uuid.NewV4().String(), Name: "Canada", ISO3166Alpha2: "CA", CallingCode: "1"},
+	}
+	c.repo.On("FindAll", ctx, query).Return(res, nil)
+
+	handler.FindCountry(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(c.T(), http.StatusOK, w.Code)
+	c.repo.AssertExpectations(c.T())
+}
+
+func (c *CountrySuite) Test_FindListCountry_OrderByInvalidField() {
+	body := []byte(`{"query":"{countries(orderBy: [{field: CODE}]) {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/countries", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.CountryQuery(c.repo),
+	})
+	if err != nil {
+		c.T().Fatal(err)
+	}
+
+	handler.FindCountry(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(c.T(), http.StatusBadRequest, w.Code)
+	c.repo.AssertNotCalled(c.T(), "FindAll", mock.Anything, mock.Anything)
+}
+
+func (c *CountrySuite) Test_FindListCountry_NegativeLimit() {
+	body := []byte(`{"query":"{countries(limit: -10) {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/countries", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.CountryQuery(c.repo),
+	})
+	if err != nil {
+		c.T().Fatal(err)
+	}
+
+	handler.FindCountry(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(c.T(), http.StatusPreconditionFailed, w.Code)
+	c.repo.AssertNotCalled(c.T(), "FindAll", mock.Anything, mock.Anything)
+}
+
+func (c *CountrySuite) Test_FindListCountry_Where() {
+	body := []byte(`{"query":"{countries(where: {name: {contains: \"land\"}, dialCode: {in: [\"64\", \"31\"]}}) {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/countries", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.CountryQuery(c.repo),
+	})
+	if err != nil {
+		c.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("Country")
+	query.Slice(config.Offset, config.Limit)
+	query.Ordering("name", provider.Ascending)
+	query.Filter("dialCode", provider.In, "64")
+	query.Filter("dialCode", provider.In, "31")
+	query.Filter("name", provider.Contains, "land")
+
+	res := []*domain.Country{
+		{
+			ID:            uuid.NewV4().String(),
+			Name:          "New Zealand",
+			ISO3166Alpha2: "NZ",
+			ISO3166Alpha3: "NZL",
+		},
+	}
+	c.repo.On("FindAll", ctx, query).Return(res, nil)
+
+	handler.FindCountry(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(c.T(), http.StatusOK, w.Code)
+}
+
 func (c *CountrySuite) Test_FindListCountry_Error() {
 	body := []byte(`{"query":"{countries(dialCode: \"1\", currencies: {id: \"e81f509f-38ec-42e8-9a1c-8e527977e526\"}) {id name createdAt updatedAt}}"}`)
 
@@ -362,3 +482,230 @@ func (c *CountrySuite) Test_FindListCountry_Failed() {
 
 	assert.Equal(c.T(), http.StatusBadRequest, w.Code)
 }
+
+func (c *CountrySuite) Test_FindCountryConnection_Success() {
+	after, _ := provider.EncodeCursor([]*provider.Ordering{
+		provider.NewOrdering("name", provider.Ascending),
+		provider.NewOrdering("id", provider.Ascending),
+	}, "Indonesia", "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	body := []byte(fmt.Sprintf(`{"query":"{countriesConnection(first: 1, after: \"%s\") {totalCount edges {node {name}} pageInfo {hasNextPage hasPreviousPage}}}"}`, after))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/countries", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.CountryQuery(c.repo),
+	})
+	if err != nil {
+		c.T().Fatal(err)
+	}
+
+	count := provider.NewQuery("Country")
+	count.Ordering("name", provider.Ascending)
+	count.Ordering("id", provider.Ascending)
+
+	query := count.Copy()
+	query.Seek([]interface{}{"Indonesia", "e81f509f-38ec-42e8-9a1c-8e527977e526"})
+	query.Slice(0, 2)
+
+	res := []*domain.Country{
+		{ID: uuid.NewV4().String(), Name: "Japan"},
+	}
+	c.repo.On("FindAll", ctx, query).Return(res, nil)
+	c.repo.On("Count", ctx, count).Return(13, nil)
+
+	handler.FindCountry(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(c.T(), http.StatusOK, w.Code)
+	assert.Contains(c.T(), w.Body.String(), `"totalCount":13`)
+	assert.Contains(c.T(), w.Body.String(), `"hasNextPage":false`)
+	assert.Contains(c.T(), w.Body.String(), `"hasPreviousPage":true`)
+}
+
+func (c *CountrySuite) Test_FindCountryConnection_ErrorCount() {
+	body := []byte(`{"query":"{countriesConnection(first: 1) {totalCount}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/countries", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.CountryQuery(c.repo),
+	})
+	if err != nil {
+		c.T().Fatal(err)
+	}
+
+	count := provider.NewQuery("Country")
+	count.Ordering("name", provider.Ascending)
+	count.Ordering("id", provider.Ascending)
+
+	query := count.Copy()
+	query.Slice(0, 2)
+
+	c.repo.On("FindAll", ctx, query).Return([]*domain.Country{}, nil)
+	c.repo.On("Count", ctx, count).Return(0, assert.AnError)
+
+	handler.FindCountry(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(c.T(), http.StatusInternalServerError, w.Code)
+}
+
+func (c *CountrySuite) Test_CountCountry_Success() {
+	body := []byte(`{"query":"{countriesCount(where: {name: {startsWith: \"New\"}})}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/countries", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.CountryQuery(c.repo),
+	})
+	if err != nil {
+		c.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("Country")
+	query.Ordering("name", provider.Ascending)
+	query.Filter("name", provider.StartsWith, "New")
+
+	c.repo.On("Count", ctx, query).Return(3, nil)
+
+	handler.FindCountry(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(c.T(), http.StatusOK, w.Code)
+	assert.Contains(c.T(), w.Body.String(), `"countriesCount":3`)
+}
+
+func (c *CountrySuite) Test_FindListCountry_BatchCurrencies() {
+	body := []byte(`{"query":"{countries(dialCode: \"62\") {name currencies {ISO4217Alphabetic}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/countries", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+	ctx = domain.WithCountryLoader(ctx, country.NewLoader(c.repo))
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.CountryQuery(c.repo),
+	})
+	if err != nil {
+		c.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("Country")
+	query.Filter("dialCode", provider.Equal, "62")
+	query.Slice(config.Offset, config.Limit)
+	query.Ordering("name", provider.Ascending)
+
+	res := []*domain.Country{
+		{ID: uuid.NewV4().String(), Name: "Indonesia"},
+		{ID: uuid.NewV4().String(), Name: "Timor-Leste"},
+	}
+	c.repo.On("FindAll", ctx, query).Return(res, nil)
+
+	currencies := provider.NewQuery(domain.CountryNode)
+	currencies.Filter("id", provider.In, res[0].ID)
+	currencies.Filter("id", provider.In, res[1].ID)
+
+	c.repo.On("Currencies", ctx, currencies).Return([]*domain.Country{
+		{ID: res[0].ID, Currencies: []*domain.Currency{{ISO4217Alphabetic: "IDR"}}},
+		{ID: res[1].ID, Currencies: []*domain.Currency{{ISO4217Alphabetic: "USD"}}},
+	}, nil)
+
+	handler.FindCountry(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(c.T(), http.StatusOK, w.Code)
+	assert.Contains(c.T(), w.Body.String(), `{"currencies":[{"ISO4217Alphabetic":"IDR"}],"name":"Indonesia"}`)
+	assert.Contains(c.T(), w.Body.String(), `{"currencies":[{"ISO4217Alphabetic":"USD"}],"name":"Timor-Leste"}`)
+	c.repo.AssertNumberOfCalls(c.T(), "Currencies", 1)
+}
+
+func (c *CountrySuite) Test_FindListCountry_BatchProvinces() {
+	body := []byte(`{"query":"{countries(dialCode: \"62\") {name provinces {name}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/countries", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	regionRepo := &regionTest.MockRepository{}
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+	ctx = domain.WithRegionLoader(ctx, region.NewLoader(regionRepo))
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.CountryQuery(c.repo),
+	})
+	if err != nil {
+		c.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("Country")
+	query.Filter("dialCode", provider.Equal, "62")
+	query.Slice(config.Offset, config.Limit)
+	query.Ordering("name", provider.Ascending)
+
+	res := []*domain.Country{
+		{ID: uuid.NewV4().String(), Name: "Indonesia"},
+		{ID: uuid.NewV4().String(), Name: "Timor-Leste"},
+	}
+	c.repo.On("FindAll", ctx, query).Return(res, nil)
+
+	provinces := provider.NewQuery(domain.CountryNode)
+	provinces.Filter("id", provider.In, res[0].ID)
+	provinces.Filter("id", provider.In, res[1].ID)
+
+	regionRepo.On("Children", ctx, provinces, domain.ProvinceNode).Return([]*domain.Region{
+		{ID: res[0].ID, Regions: domain.Regions{domain.ProvinceNode: []*domain.Region{{Name: "Jawa Barat"}}}},
+	}, nil)
+
+	handler.FindCountry(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(c.T(), http.StatusOK, w.Code)
+	assert.Contains(c.T(), w.Body.String(), `{"name":"Indonesia","provinces":[{"name":"Jawa Barat"}]}`)
+	assert.Contains(c.T(), w.Body.String(), `{"name":"Timor-Leste","provinces":[]}`)
+	regionRepo.AssertNumberOfCalls(c.T(), "Children", 1)
+}
+
+func (c *CountrySuite) Test_FindListCountry_Neighbours() {
+	body := []byte(`{"query":"{countries(dialCode: \"62\") {name neighbours {ISO3166Alpha2}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/countries", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+	ctx = domain.WithCountryLoader(ctx, country.NewLoader(c.repo))
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.CountryQuery(c.repo),
+	})
+	if err != nil {
+		c.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("Country")
+	query.Filter("dialCode", provider.Equal, "62")
+	query.Slice(config.Offset, config.Limit)
+	query.Ordering("name", provider.Ascending)
+
+	id := uuid.NewV4().String()
+	c.repo.On("FindAll", ctx, query).Return([]*domain.Country{{ID: id, Name: "Indonesia"}}, nil)
+	c.repo.On("Neighbours", ctx, provider.NewQuery(domain.CountryNode).Filter("id", provider.In, id)).
+		Return([]*domain.Country{{ID: id, Neighbours: []*domain.Country{
+			{ISO3166Alpha2: "MY"}, {ISO3166Alpha2: "PG"}, {ISO3166Alpha2: "TL"},
+		}}}, nil)
+
+	handler.FindCountry(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(c.T(), http.StatusOK, w.Code)
+	assert.Contains(c.T(), w.Body.String(), `"countries":[{"name":"Indonesia","neighbours":[{"ISO3166Alpha2":"MY"},{"ISO3166Alpha2":"PG"},{"ISO3166Alpha2":"TL"}]}]`)
+}
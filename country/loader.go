@@ -0,0 +1,93 @@
+package country
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+
+	"github.com/dynastymasra/cartographer/domain"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+
+	"github.com/dynastymasra/cookbook"
+	"github.com/sirupsen/logrus"
+)
+
+// Loader batches the currency and neighbour lookups of one request into one IN query, create new loader for every request
+type Loader struct {
+	currencies *provider.Batch
+	neighbours *provider.Batch
+}
+
+func NewLoader(repo Repository) *Loader {
+	loader := &Loader{}
+
+	loader.currencies = provider.NewBatch(func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: ctx.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(loader.Currencies).Pointer()).Name(),
+		})
+
+		query := provider.NewQuery(domain.CountryNode)
+		for _, id := range ids {
+			query.Filter("id", provider.In, id)
+		}
+
+		results, err := repo.Currencies(ctx, query)
+		if err != nil {
+			return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find currencies from storage")
+		}
+
+		values := make(map[string]interface{}, len(results))
+		for _, result := range results {
+			values[result.ID] = result.Currencies
+		}
+
+		return values, nil
+	})
+
+	loader.neighbours = provider.NewBatch(func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: ctx.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(loader.Neighbours).Pointer()).Name(),
+		})
+
+		query := provider.NewQuery(domain.CountryNode)
+		for _, id := range ids {
+			query.Filter("id", provider.In, id)
+		}
+
+		results, err := repo.Neighbours(ctx, query)
+		if err != nil {
+			return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find neighbours from storage")
+		}
+
+		values := make(map[string]interface{}, len(results))
+		for _, result := range results {
+			values[result.ID] = result.Neighbours
+		}
+
+		return values, nil
+	})
+
+	return loader
+}
+
+// Currencies loads currencies of the country with the id
+func (l *Loader) Currencies(ctx context.Context, id string) func() ([]*domain.Currency, error) {
+	load := l.currencies.Load(ctx, id)
+	return func() ([]*domain.Currency, error) {
+		value, err := load()
+		currencies, _ := value.([]*domain.Currency)
+		return currencies, err
+	}
+}
+
+// Neighbours loads the countries sharing a border with the country with the id
+func (l *Loader) Neighbours(ctx context.Context, id string) func() ([]*domain.Country, error) {
+	load := l.neighbours.Load(ctx, id)
+	return func() ([]*domain.Country, error) {
+		value, err := load()
+		neighbours, _ := value.([]*domain.Country)
+		return neighbours, err
+	}
+}
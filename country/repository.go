@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
 
 	"github.com/dynastymasra/cartographer/domain"
@@ -19,6 +20,9 @@ import (
 type Repository interface {
 	Find(context.Context, *provider.Query) (*domain.Country, error)
 	FindAll(context.Context, *provider.Query) ([]*domain.Country, error)
+	Count(context.Context, *provider.Query) (int, error)
+	Currencies(context.Context, *provider.Query) ([]*domain.Country, error)
+	Neighbours(context.Context, *provider.Query) ([]*domain.Country, error)
 }
 
 type RepositoryInstance struct {
@@ -43,7 +47,11 @@ func (r *RepositoryInstance) Find(ctx context.Context, query *provider.Query) (*
 	defer session.Close()
 
 	node := strings.ToLower(query.Node)
-	match, where, _, value := provider.TranslateQuery(query)
+	match, where, _, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
 
 	/**
 	MATCH p = (country:Country)-[*0..1]->()
@@ -89,10 +97,14 @@ func (r *RepositoryInstance) FindAll(ctx context.Context, query *provider.Query)
 	defer session.Close()
 
 	node := strings.ToLower(query.Node)
-	match, where, order, value := provider.TranslateQuery(query)
+	match, where, order, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
 
 	/**
-	MATCH p = (country:Country), (country)-[*]->(province:Province)
+	MATCH p = (country:Country), (country)-[:PROVINCES]->(province:Province)
 		WHERE province.code="34"
 		WITH p, country
 		ORDER BY country.name ASC SKIP 0 LIMIT 5
@@ -130,3 +142,154 @@ func (r *RepositoryInstance) FindAll(ctx context.Context, query *provider.Query)
 
 	return countries, nil
 }
+
+func (r *RepositoryInstance) Count(ctx context.Context, query *provider.Query) (int, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(r.Count).Pointer()).Name(),
+	})
+
+	session, err := r.driver.Session(neo4j.AccessModeRead)
+	if err != nil {
+		log.WithError(err).Errorln("Failed create new session")
+		return 0, err
+	}
+	defer session.Close()
+
+	node := strings.ToLower(query.Node)
+	match, where, _, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return 0, err
+	}
+
+	/**
+	MATCH (country:Country), (country)-[:CURRENCIES]->(currency:Currency)
+		WHERE currency.ISO4217Alphabetic IN $`currency.ISO4217Alphabetic`
+	RETURN COUNT(DISTINCT country) AS total
+	*/
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			RETURN COUNT(DISTINCT %s) AS total`, match, where, node)
+
+	record, err := neo4j.Single(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return 0, err
+	}
+
+	total, ok := record.GetByIndex(0).(int64)
+	if !ok {
+		log.WithField("total", record.GetByIndex(0)).Errorln("Failed parse result to number")
+		return 0, fmt.Errorf("invalid count result %v", record.GetByIndex(0))
+	}
+
+	return int(total), nil
+}
+
+// Currencies finds the countries match the query with the currencies
+func (r *RepositoryInstance) Currencies(ctx context.Context, query *provider.Query) ([]*domain.Country, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(r.Currencies).Pointer()).Name(),
+	})
+
+	session, err := r.driver.Session(neo4j.AccessModeRead)
+	if err != nil {
+		log.WithError(err).Errorln("Failed create new session")
+		return nil, err
+	}
+	defer session.Close()
+
+	match, where, _, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
+
+	/**
+	MATCH p = (country:Country)-[:CURRENCIES]->(:Currency)
+		WHERE country.id IN $`country.id`
+		WITH COLLECT(p) AS val
+		CALL apoc.convert.toTree(val) YIELD value
+	RETURN COLLECT(value) AS value
+	*/
+	filter := fmt.Sprintf(`MATCH p = %s%s(:%s)
+			%s
+			WITH COLLECT(p) AS val
+			CALL apoc.convert.toTree(val) YIELD value
+			RETURN COLLECT(value) AS value`,
+		match, provider.RelationshipOf(domain.CountryNode, domain.CurrencyNode).Step(), domain.CurrencyNode, where)
+
+	records, err := neo4j.Collect(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return nil, err
+	}
+
+	var countries []*domain.Country
+	if len(records) > 0 {
+		if err := provider.RecordUnmarshal(records[0].GetByIndex(0), &countries); err != nil {
+			log.WithError(err).Errorln("Failed parse result to struct")
+			return nil, err
+		}
+	}
+
+	return countries, nil
+}
+
+// Neighbours finds the countries match the query with the countries sharing a land border ordered by name
+func (r *RepositoryInstance) Neighbours(ctx context.Context, query *provider.Query) ([]*domain.Country, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(r.Neighbours).Pointer()).Name(),
+	})
+
+	session, err := r.driver.Session(neo4j.AccessModeRead)
+	if err != nil {
+		log.WithError(err).Errorln("Failed create new session")
+		return nil, err
+	}
+	defer session.Close()
+
+	node := strings.ToLower(query.Node)
+	match, where, _, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
+
+	/**
+	MATCH (country:Country)
+		WHERE country.id IN $`country.id`
+		WITH DISTINCT country
+	RETURN COLLECT(country {.id, neighbours: [(country)-[:BORDERS]-(neighbour:Country) | neighbour {.*}]}) AS value
+	*/
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH DISTINCT %s
+			RETURN COLLECT(%s {.id, neighbours: [(%s)-[:%s]-(neighbour:%s) | neighbour {.*}]}) AS value`,
+		match, where, node, node, node, domain.BordersRelationship, domain.CountryNode)
+
+	records, err := neo4j.Collect(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return nil, err
+	}
+
+	var countries []*domain.Country
+	if len(records) > 0 {
+		if err := provider.RecordUnmarshal(records[0].GetByIndex(0), &countries); err != nil {
+			log.WithError(err).Errorln("Failed parse result to struct")
+			return nil, err
+		}
+	}
+
+	for _, country := range countries {
+		sort.Slice(country.Neighbours, func(i, j int) bool {
+			return country.Neighbours[i].Name < country.Neighbours[j].Name
+		})
+	}
+
+	return countries, nil
+}
@@ -54,7 +54,7 @@ func (r *RepositorySuite) Test_Find_Error() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, _, value := provider.TranslateQuery(query)
+	match, where, _, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s-[*0..1]->()
 			%s
@@ -77,7 +77,7 @@ func (r *RepositorySuite) Test_Find_ErrorUnmarshal() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, _, value := provider.TranslateQuery(query)
+	match, where, _, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s-[*0..1]->()
 			%s
@@ -104,7 +104,7 @@ func (r *RepositorySuite) Test_Find_Success() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, _, value := provider.TranslateQuery(query)
+	match, where, _, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s-[*0..1]->()
 			%s
@@ -151,7 +151,7 @@ func (r *RepositorySuite) Test_FindAll_Error() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, order, value := provider.TranslateQuery(query)
+	match, where, order, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s
 			%s
@@ -175,7 +175,7 @@ func (r *RepositorySuite) Test_FindAll_ErrorUnmarshal() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, order, value := provider.TranslateQuery(query)
+	match, where, order, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s
 			%s
@@ -203,7 +203,7 @@ func (r *RepositorySuite) Test_FindAll_Empty() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, order, value := provider.TranslateQuery(query)
+	match, where, order, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s
 			%s
@@ -234,7 +234,7 @@ func (r *RepositorySuite) Test_FindAll_Success() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, order, value := provider.TranslateQuery(query)
+	match, where, order, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s
 			%s
@@ -298,3 +298,196 @@ func (r *RepositorySuite) Test_FindAll_Success() {
 	assert.NotEmpty(r.T(), res)
 	assert.NoError(r.T(), err)
 }
+
+func (r *RepositorySuite) Test_Count_ErrorSession() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, assert.AnError)
+
+	repo := country.NewRepository(r.provider)
+
+	res, err := repo.Count(context.Background(), &provider.Query{})
+
+	assert.Zero(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Count_Error() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			RETURN COUNT(DISTINCT %s) AS total`, match, where, "test")
+
+	r.provider.On("Run", filter, value).Return(r.provider, assert.AnError)
+
+	repo := country.NewRepository(r.provider)
+	res, err := repo.Count(context.Background(), query)
+
+	assert.Zero(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Count_ErrorParse() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			RETURN COUNT(DISTINCT %s) AS total`, match, where, "test")
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return("<-")
+
+	repo := country.NewRepository(r.provider)
+	res, err := repo.Count(context.Background(), query)
+
+	assert.Zero(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Count_Success() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			RETURN COUNT(DISTINCT %s) AS total`, match, where, "test")
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return(int64(514))
+
+	repo := country.NewRepository(r.provider)
+	res, err := repo.Count(context.Background(), query)
+
+	assert.Equal(r.T(), 514, res)
+	assert.NoError(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Currencies_ErrorSession() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, assert.AnError)
+
+	repo := country.NewRepository(r.provider)
+
+	res, err := repo.Currencies(context.Background(), &provider.Query{})
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Currencies_Error() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH p = %s-[:CURRENCIES]->(:Currency)
+			%s
+			WITH COLLECT(p) AS val
+			CALL apoc.convert.toTree(val) YIELD value
+			RETURN COLLECT(value) AS value`, match, where)
+
+	r.provider.On("Run", filter, value).Return(r.provider, assert.AnError)
+
+	repo := country.NewRepository(r.provider)
+	res, err := repo.Currencies(context.Background(), query)
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Currencies_Success() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH p = %s-[:CURRENCIES]->(:Currency)
+			%s
+			WITH COLLECT(p) AS val
+			CALL apoc.convert.toTree(val) YIELD value
+			RETURN COLLECT(value) AS value`, match, where)
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return([]map[string]interface{}{
+		{
+			"id":   "e81f509f-38ec-42e8-9a1c-8e527977e526",
+			"name": "Indonesia",
+			"currencies": []map[string]interface{}{
+				{"ISO4217Name": "Rupiah", "ISO4217Alphabetic": "IDR"},
+			},
+		},
+	})
+
+	repo := country.NewRepository(r.provider)
+	res, err := repo.Currencies(context.Background(), query)
+
+	assert.Len(r.T(), res, 1)
+	assert.Equal(r.T(), "IDR", res[0].Currencies[0].ISO4217Alphabetic)
+	assert.NoError(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Neighbours_ErrorSession() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, assert.AnError)
+
+	repo := country.NewRepository(r.provider)
+
+	res, err := repo.Neighbours(context.Background(), &provider.Query{})
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Neighbours_Success() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Country")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH DISTINCT country
+			RETURN COLLECT(country {.id, neighbours: [(country)-[:BORDERS]-(neighbour:Country) | neighbour {.*}]}) AS value`,
+		match, where)
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return([]map[string]interface{}{
+		{
+			"id": "e81f509f-38ec-42e8-9a1c-8e527977e526",
+			"neighbours": []map[string]interface{}{
+				{"name": "Timor-Leste", "ISO3166Alpha2": "TL"},
+				{"name": "Malaysia", "ISO3166Alpha2": "MY"},
+			},
+		},
+	})
+
+	repo := country.NewRepository(r.provider)
+	res, err := repo.Neighbours(context.Background(), query)
+
+	assert.NoError(r.T(), err)
+	assert.Len(r.T(), res, 1)
+	assert.Equal(r.T(), "MY", res[0].Neighbours[0].ISO3166Alpha2)
+}
@@ -22,3 +22,18 @@ func (m *MockRepository) FindAll(ctx context.Context, query *provider.Query) ([]
 	args := m.Called(ctx, query)
 	return args.Get(0).([]*domain.Country), args.Error(1)
 }
+
+func (m *MockRepository) Count(ctx context.Context, query *provider.Query) (int, error) {
+	args := m.Called(ctx, query)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRepository) Currencies(ctx context.Context, query *provider.Query) ([]*domain.Country, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*domain.Country), args.Error(1)
+}
+
+func (m *MockRepository) Neighbours(ctx context.Context, query *provider.Query) ([]*domain.Country, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*domain.Country), args.Error(1)
+}
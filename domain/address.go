@@ -0,0 +1,37 @@
+package domain
+
+type (
+	// AddressCandidate is the administrative divisions matched from the address, regions are ordered from the province
+	AddressCandidate struct {
+		Confidence float64   `json:"confidence"`
+		Country    *Country  `json:"country"`
+		Regions    []*Region `json:"regions"`
+	}
+
+	// AddressPhrase is the part of the address searched as one administrative division name
+	AddressPhrase struct {
+		Name
+		// Hits are the matched administrative divisions of the phrase
+		Hits []*Region
+	}
+)
+
+// Region returns the matched administrative division of the node label, nil when the level is not matched
+func (a *AddressCandidate) Region(level string) *Region {
+	for _, region := range a.Regions {
+		if region.Level == level {
+			return region
+		}
+	}
+
+	return nil
+}
+
+// Lowest returns the lowest matched administrative division
+func (a *AddressCandidate) Lowest() *Region {
+	if len(a.Regions) < 1 {
+		return nil
+	}
+
+	return a.Regions[len(a.Regions)-1]
+}
@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/dynastymasra/cartographer/config"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+)
+
+type (
+	// Connection is relay cursor connection of list query
+	Connection struct {
+		Edges    []*Edge  `json:"edges"`
+		PageInfo PageInfo `json:"pageInfo"`
+		// TotalCount count all nodes match the filters regardless of the page, only called when requested
+		TotalCount func(context.Context) (int, error) `json:"-"`
+	}
+
+	Edge struct {
+		Cursor string      `json:"cursor"`
+		Node   interface{} `json:"node"`
+	}
+
+	PageInfo struct {
+		HasNextPage     bool   `json:"hasNextPage"`
+		HasPreviousPage bool   `json:"hasPreviousPage"`
+		StartCursor     string `json:"startCursor"`
+		EndCursor       string `json:"endCursor"`
+	}
+
+	// Page is relay pagination arguments, First and After walk forward, Last and Before walk backward
+	Page struct {
+		First  int
+		Last   int
+		After  string
+		Before string
+	}
+)
+
+// NewPage reads relay pagination arguments, First is default to config.Limit when First and Last are empty
+func NewPage(args map[string]interface{}) (*Page, error) {
+	page := &Page{}
+
+	page.First, _ = args["first"].(int)
+	page.Last, _ = args["last"].(int)
+	page.After, _ = args["after"].(string)
+	page.Before, _ = args["before"].(string)
+
+	if page.First < 0 || page.Last < 0 {
+		return nil, config.NewError(http.StatusPreconditionFailed, "page", "first and last must be positive")
+	}
+
+	if page.First > 0 && page.Last > 0 {
+		return nil, config.NewError(http.StatusPreconditionFailed, "page", "first and last cannot be used together")
+	}
+
+	if page.First == 0 && page.Last == 0 {
+		page.First = config.Limit
+	}
+
+	return page, nil
+}
+
+// SliceArgs reads offset and limit arguments of list query, limit must be positive so the query is never unbounded
+func SliceArgs(args map[string]interface{}) (int, int, error) {
+	offset, _ := args["offset"].(int)
+	limit, _ := args["limit"].(int)
+
+	if limit < 1 {
+		return 0, 0, config.NewError(http.StatusPreconditionFailed, "limit", "limit must be greater than zero")
+	}
+
+	if offset < 0 {
+		return 0, 0, config.NewError(http.StatusPreconditionFailed, "offset", "offset must not be negative")
+	}
+
+	return offset, limit, nil
+}
+
+func (p *Page) backward() bool {
+	return p.Last > 0
+}
+
+func (p *Page) size() int {
+	if p.backward() {
+		return p.Last
+	}
+	return p.First
+}
+
+// Apply adds the cursor position and page size to the query, the query must be ordered,
+// query order is reversed when walk backward and it gets one node more to check the next page,
+// the ordering properties are selected when the query is projected so every cursor has the ordering values
+func (p *Page) Apply(query *provider.Query) error {
+	if len(query.Orderings) < 1 {
+		return config.NewError(http.StatusInternalServerError, "", "query must be ordered to use cursor")
+	}
+
+	if len(p.After) > 0 {
+		values, err := cursorValues(p.After, query.Orderings)
+		if err != nil {
+			return config.NewError(http.StatusPreconditionFailed, "after", err.Error())
+		}
+		query.Seek(values)
+	}
+
+	if len(p.Before) > 0 {
+		values, err := cursorValues(p.Before, query.Orderings)
+		if err != nil {
+			return config.NewError(http.StatusPreconditionFailed, "before", err.Error())
+		}
+		query.Reverse().Seek(values).Reverse()
+	}
+
+	if query.Projected() {
+		for _, order := range query.Orderings {
+			query.Select(order.Field)
+		}
+	}
+
+	if p.backward() {
+		query.Reverse()
+	}
+	query.Slice(0, p.size()+1)
+
+	return nil
+}
+
+// Connection creates the connection from nodes result of query applied by the page,
+// the cursor of each node is encoded from the node properties of orderings
+func (p *Page) Connection(nodes []interface{}, orderings []*provider.Ordering) (*Connection, error) {
+	more := len(nodes) > p.size()
+	if more {
+		nodes = nodes[:p.size()]
+	}
+
+	if p.backward() {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+
+	connection := &Connection{
+		Edges: make([]*Edge, 0, len(nodes)),
+		PageInfo: PageInfo{
+			HasNextPage:     more,
+			HasPreviousPage: len(p.After) > 0,
+		},
+	}
+
+	if p.backward() {
+		connection.PageInfo.HasNextPage = len(p.Before) > 0
+		connection.PageInfo.HasPreviousPage = more
+	}
+
+	for _, node := range nodes {
+		var properties map[string]interface{}
+		if err := provider.RecordUnmarshal(node, &properties); err != nil {
+			return nil, err
+		}
+
+		values := make([]interface{}, 0, len(orderings))
+		for _, order := range orderings {
+			values = append(values, properties[order.Field])
+		}
+
+		cursor, err := provider.EncodeCursor(orderings, values...)
+		if err != nil {
+			return nil, err
+		}
+
+		connection.Edges = append(connection.Edges, &Edge{
+			Cursor: cursor,
+			Node:   node,
+		})
+	}
+
+	if len(connection.Edges) > 0 {
+		connection.PageInfo.StartCursor = connection.Edges[0].Cursor
+		connection.PageInfo.EndCursor = connection.Edges[len(connection.Edges)-1].Cursor
+	}
+
+	return connection, nil
+}
+
+// cursorValues returns the ordering values of the cursor, the cursor must be created with the same orderings
+func cursorValues(cursor string, orderings []*provider.Ordering) ([]interface{}, error) {
+	cursorOrderings, values, err := provider.DecodeCursor(cursor)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	if !provider.SameOrderings(cursorOrderings, orderings) {
+		return nil, errors.New("cursor is created with different orderBy")
+	}
+
+	return values, nil
+}
@@ -12,6 +12,14 @@ const (
 	CountryNode  = "Country"
 )
 
+var (
+	// CountryProperties are the stored properties of the country nodes
+	CountryProperties = []string{"id", "name", "ISO3166Alpha2", "ISO3166Alpha3", "ISO3166Numeric", "dialCode", "flags", "location", "createdAt", "updatedAt"}
+
+	// CurrencyProperties are the stored properties of the currency nodes
+	CurrencyProperties = []string{"id", "ISO4217Name", "ISO4217Alphabetic", "ISO4217Numeric", "ISO4217MinorUnit", "createdAt", "updatedAt"}
+)
+
 type (
 	Country struct {
 		ID             string      `json:"id"`
@@ -23,9 +31,12 @@ type (
 		Currencies     []*Currency `json:"currencies"`
 		Flag           Flag        `json:"flag"`
 		FlagStr        string      `json:"flags"`
-		Regions
-		CreatedAt time.Time `json:"createdAt"`
-		UpdatedAt time.Time `json:"updatedAt"`
+		Location       *Location   `json:"location,omitempty"`
+		// Neighbours are the countries sharing a land border, only filled by the neighbour lookup
+		Neighbours []*Country `json:"neighbours,omitempty"`
+		Regions    `json:"-"`
+		CreatedAt  time.Time `json:"createdAt"`
+		UpdatedAt  time.Time `json:"updatedAt"`
 	}
 
 	Currency struct {
@@ -51,6 +62,22 @@ type (
 	}
 )
 
+// UnmarshalJSON reads the country and the administrative divisions under the list fields of the levels
+func (c *Country) UnmarshalJSON(data []byte) error {
+	type country Country
+	if err := json.Unmarshal(data, (*country)(c)); err != nil {
+		return err
+	}
+
+	regions, err := unmarshalRegions(data)
+	if err != nil {
+		return err
+	}
+	c.Regions = regions
+
+	return nil
+}
+
 func (c *Country) Unmarshal() error {
 	var flag Flag
 
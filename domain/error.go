@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/dynastymasra/cartographer/config"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+
+	"github.com/sirupsen/logrus"
+)
+
+// StorageError logs and returns the service error of the failed storage call, the query with unknown property
+// or condition is built from the client arguments, so it's the client error logged as warning
+func StorageError(log *logrus.Entry, err error, message string) *config.ServiceError {
+	var property *provider.PropertyError
+	if errors.As(err, &property) {
+		log.WithError(err).Warnln(message)
+		return config.NewError(http.StatusPreconditionFailed, property.Field, err.Error())
+	}
+
+	log.WithError(err).Errorln(message)
+	return config.NewError(http.StatusInternalServerError, "", err.Error())
+}
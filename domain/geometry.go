@@ -0,0 +1,264 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+const (
+	// BoundaryNode is the node of the boundary geometry, linked from the administrative division with BOUNDARY
+	BoundaryNode = "Boundary"
+
+	Polygon      = "Polygon"
+	MultiPolygon = "MultiPolygon"
+)
+
+type (
+	// Boundary is the stored geometry of the administrative division, geometry is the GeoJSON string
+	Boundary struct {
+		ID       string `json:"id"`
+		Code     string `json:"code"`
+		Name     string `json:"name"`
+		Level    string `json:"level"`
+		Geometry string `json:"geometry,omitempty"`
+		// BBox is the GeoJSON bounding box of the geometry, west, south, east and north
+		BBox []float64 `json:"bbox,omitempty"`
+	}
+
+	// Position is the longitude and latitude of the GeoJSON coordinate
+	Position [2]float64
+
+	// Geometry is the GeoJSON polygon or multi polygon, the first ring of each polygon is the exterior
+	Geometry struct {
+		Type     string
+		Polygons [][][]Position
+	}
+
+	geoJSON struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates,omitempty"`
+		Geometry    json.RawMessage `json:"geometry,omitempty"`
+	}
+)
+
+// ParseGeometry parses the GeoJSON polygon or multi polygon, the geometry of the feature is parsed for feature
+func ParseGeometry(data []byte) (*Geometry, error) {
+	var value geoJSON
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	switch value.Type {
+	case "Feature":
+		if len(value.Geometry) < 1 || string(value.Geometry) == "null" {
+			return nil, fmt.Errorf("feature has no geometry")
+		}
+		return ParseGeometry(value.Geometry)
+	case Polygon:
+		var polygon [][]Position
+		if err := json.Unmarshal(value.Coordinates, &polygon); err != nil {
+			return nil, err
+		}
+		return &Geometry{Type: Polygon, Polygons: [][][]Position{polygon}}, nil
+	case MultiPolygon:
+		var polygons [][][]Position
+		if err := json.Unmarshal(value.Coordinates, &polygons); err != nil {
+			return nil, err
+		}
+		return &Geometry{Type: MultiPolygon, Polygons: polygons}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported geometry type %q", value.Type)
+}
+
+// MarshalJSON writes the geometry as GeoJSON
+func (g *Geometry) MarshalJSON() ([]byte, error) {
+	var coordinates interface{} = g.Polygons
+	if g.Type == Polygon && len(g.Polygons) == 1 {
+		coordinates = g.Polygons[0]
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"type":        g.Type,
+		"coordinates": coordinates,
+	})
+}
+
+// Bounds returns the GeoJSON bounding box of the exterior rings, west, south, east and north
+func (g *Geometry) Bounds() []float64 {
+	bbox := []float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	for _, polygon := range g.Polygons {
+		if len(polygon) < 1 {
+			continue
+		}
+
+		for _, position := range polygon[0] {
+			bbox[0], bbox[1] = math.Min(bbox[0], position[0]), math.Min(bbox[1], position[1])
+			bbox[2], bbox[3] = math.Max(bbox[2], position[0]), math.Max(bbox[3], position[1])
+		}
+	}
+
+	return bbox
+}
+
+// Contains checks whether the position is inside the exterior ring and outside the holes of one of the polygons
+func (g *Geometry) Contains(position Position) bool {
+	for _, polygon := range g.Polygons {
+		if len(polygon) < 1 || !inside(polygon[0], position) {
+			continue
+		}
+
+		hole := false
+		for _, ring := range polygon[1:] {
+			if inside(ring, position) {
+				hole = true
+				break
+			}
+		}
+
+		if !hole {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Touches checks whether the geometries share a border, tolerance is in degrees, at least two positions of one
+// geometry must be within the tolerance of the rings of the other so polygons meeting at one corner don't touch
+func (g *Geometry) Touches(other *Geometry, tolerance float64) bool {
+	return nearPositions(g, other, tolerance) >= 2 || nearPositions(other, g, tolerance) >= 2
+}
+
+// nearPositions counts the positions of the rings of g within the tolerance of the rings of other, up to two
+func nearPositions(g, other *Geometry, tolerance float64) int {
+	bounds := other.Bounds()
+
+	var count int
+	for _, polygon := range g.Polygons {
+		for _, ring := range polygon {
+			if len(ring) < 1 {
+				continue
+			}
+
+			// the last position of the closed ring is the first position
+			for _, position := range ring[:len(ring)-1] {
+				if position[0] < bounds[0]-tolerance || position[0] > bounds[2]+tolerance ||
+					position[1] < bounds[1]-tolerance || position[1] > bounds[3]+tolerance {
+					continue
+				}
+
+				if other.near(position, tolerance) {
+					if count++; count >= 2 {
+						return count
+					}
+				}
+			}
+		}
+	}
+
+	return count
+}
+
+// near checks whether the position is within the tolerance of one of the ring edges
+func (g *Geometry) near(position Position, tolerance float64) bool {
+	for _, polygon := range g.Polygons {
+		for _, ring := range polygon {
+			for i := 1; i < len(ring); i++ {
+				if segmentDistance(position, ring[i-1], ring[i]) <= tolerance {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// inside casts the ray from the position to the east and counts the crossed edges of the ring
+func inside(ring []Position, position Position) bool {
+	var crossed bool
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a[1] > position[1]) != (b[1] > position[1]) &&
+			position[0] < (b[0]-a[0])*(position[1]-a[1])/(b[1]-a[1])+a[0] {
+			crossed = !crossed
+		}
+	}
+
+	return crossed
+}
+
+// Simplify returns the geometry with the rings simplified by Douglas-Peucker, tolerance is in degrees,
+// the ring is kept when it would have less than four positions
+func (g *Geometry) Simplify(tolerance float64) *Geometry {
+	if tolerance <= 0 {
+		return g
+	}
+
+	polygons := make([][][]Position, 0, len(g.Polygons))
+	for _, polygon := range g.Polygons {
+		rings := make([][]Position, 0, len(polygon))
+		for _, ring := range polygon {
+			simplified := simplify(ring, tolerance)
+			if len(simplified) < 4 {
+				simplified = ring
+			}
+			rings = append(rings, simplified)
+		}
+		polygons = append(polygons, rings)
+	}
+
+	return &Geometry{Type: g.Type, Polygons: polygons}
+}
+
+func simplify(positions []Position, tolerance float64) []Position {
+	if len(positions) < 3 {
+		return positions
+	}
+
+	keep := make([]bool, len(positions))
+	keep[0], keep[len(positions)-1] = true, true
+
+	// stack of the first and last index of the unchecked sections
+	stack := [][2]int{{0, len(positions) - 1}}
+	for len(stack) > 0 {
+		section := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		index, farthest := -1, tolerance
+		for i := section[0] + 1; i < section[1]; i++ {
+			if distance := segmentDistance(positions[i], positions[section[0]], positions[section[1]]); distance > farthest {
+				index, farthest = i, distance
+			}
+		}
+
+		if index > 0 {
+			keep[index] = true
+			stack = append(stack, [2]int{section[0], index}, [2]int{index, section[1]})
+		}
+	}
+
+	results := make([]Position, 0, len(positions))
+	for i, position := range positions {
+		if keep[i] {
+			results = append(results, position)
+		}
+	}
+
+	return results
+}
+
+// segmentDistance returns the planar distance of the position to the segment from a to b
+func segmentDistance(p, a, b Position) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	if dx == 0 && dy == 0 {
+		return math.Hypot(p[0]-a[0], p[1]-a[1])
+	}
+
+	t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / (dx*dx + dy*dy)
+	t = math.Max(0, math.Min(1, t))
+
+	return math.Hypot(p[0]-(a[0]+t*dx), p[1]-(a[1]+t*dy))
+}
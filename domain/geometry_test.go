@@ -0,0 +1,104 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type GeometrySuite struct {
+	suite.Suite
+}
+
+func Test_GeometrySuite(t *testing.T) {
+	suite.Run(t, new(GeometrySuite))
+}
+
+func (g *GeometrySuite) Test_ParseGeometry_Feature() {
+	geometry, err := domain.ParseGeometry([]byte(`{"type":"Feature","properties":{"code":"32"},
+		"geometry":{"type":"Polygon","coordinates":[[[107,-7],[108,-7],[108,-6],[107,-7]]]}}`))
+
+	assert.NoError(g.T(), err)
+	assert.Equal(g.T(), domain.Polygon, geometry.Type)
+	assert.Equal(g.T(), []domain.Position{{107, -7}, {108, -7}, {108, -6}, {107, -7}}, geometry.Polygons[0][0])
+
+	res, _ := json.Marshal(geometry)
+	assert.Equal(g.T(), `{"coordinates":[[[107,-7],[108,-7],[108,-6],[107,-7]]],"type":"Polygon"}`, string(res))
+}
+
+func (g *GeometrySuite) Test_ParseGeometry_MultiPolygon() {
+	geometry, err := domain.ParseGeometry([]byte(`{"type":"MultiPolygon","coordinates":[
+		[[[106,-6],[107,-6],[107,-5],[106,-6]]],
+		[[[108,-7],[109,-7],[109,-6],[108,-7]]]]}`))
+
+	assert.NoError(g.T(), err)
+	assert.Len(g.T(), geometry.Polygons, 2)
+}
+
+func (g *GeometrySuite) Test_ParseGeometry_Unsupported() {
+	_, err := domain.ParseGeometry([]byte(`{"type":"Point","coordinates":[107,-7]}`))
+
+	assert.EqualError(g.T(), err, `unsupported geometry type "Point"`)
+}
+
+func (g *GeometrySuite) Test_Simplify() {
+	geometry := &domain.Geometry{Type: domain.Polygon, Polygons: [][][]domain.Position{{
+		{{0, 0}, {1, 0.001}, {2, 0}, {2, 2}, {1, 2.5}, {0, 2}, {0, 0}},
+	}}}
+
+	simplified := geometry.Simplify(0.01)
+
+	assert.Equal(g.T(), []domain.Position{{0, 0}, {2, 0}, {2, 2}, {1, 2.5}, {0, 2}, {0, 0}}, simplified.Polygons[0][0])
+	assert.Len(g.T(), geometry.Polygons[0][0], 7)
+}
+
+func (g *GeometrySuite) Test_Simplify_KeepRing() {
+	geometry := &domain.Geometry{Type: domain.Polygon, Polygons: [][][]domain.Position{{
+		{{0, 0}, {0.001, 0}, {0.001, 0.001}, {0, 0}},
+	}}}
+
+	assert.Equal(g.T(), geometry.Polygons, geometry.Simplify(1).Polygons)
+}
+
+func (g *GeometrySuite) Test_Contains() {
+	geometry := &domain.Geometry{Type: domain.MultiPolygon, Polygons: [][][]domain.Position{
+		{
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}},
+		},
+		{
+			{{20, 0}, {30, 0}, {25, 10}, {20, 0}},
+		},
+	}}
+
+	assert.True(g.T(), geometry.Contains(domain.Position{2, 2}))
+	assert.False(g.T(), geometry.Contains(domain.Position{5, 5}))
+	assert.True(g.T(), geometry.Contains(domain.Position{25, 5}))
+	assert.False(g.T(), geometry.Contains(domain.Position{21, 8}))
+	assert.False(g.T(), geometry.Contains(domain.Position{15, 5}))
+	assert.Equal(g.T(), []float64{0, 0, 30, 10}, geometry.Bounds())
+}
+
+func (g *GeometrySuite) Test_Touches() {
+	west := &domain.Geometry{Type: domain.Polygon, Polygons: [][][]domain.Position{{
+		{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}},
+	}}}
+	east := &domain.Geometry{Type: domain.Polygon, Polygons: [][][]domain.Position{{
+		{{1.00001, 0}, {2, 0}, {2, 1}, {1.00001, 1}, {1.00001, 0}},
+	}}}
+	corner := &domain.Geometry{Type: domain.Polygon, Polygons: [][][]domain.Position{{
+		{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}},
+	}}}
+	island := &domain.Geometry{Type: domain.Polygon, Polygons: [][][]domain.Position{{
+		{{5, 5}, {6, 5}, {6, 6}, {5, 5}},
+	}}}
+
+	assert.True(g.T(), west.Touches(east, 0.0001))
+	assert.False(g.T(), west.Touches(east, 0))
+	assert.False(g.T(), west.Touches(corner, 0.0001))
+	assert.False(g.T(), west.Touches(island, 0.0001))
+}
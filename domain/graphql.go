@@ -3,13 +3,14 @@ package domain
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/dynastymasra/cartographer/config"
-
-	"github.com/labstack/gommon/random"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
 
 	scalar "github.com/dynastymasra/cookbook/graphql"
 	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
 )
 
 var (
@@ -32,6 +33,9 @@ var (
 		"ISO3166Numeric": &graphql.Field{
 			Type: graphql.String,
 		},
+		"location": &graphql.Field{
+			Type: LocationType,
+		},
 		"flags": &graphql.Field{
 			Type: flagType,
 			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
@@ -56,14 +60,24 @@ var (
 			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 				var currencies []*Currency
 
+				var id string
+
 				switch country := p.Source.(type) {
 				case *Country:
-					currencies = country.Currencies
+					id, currencies = country.ID, country.Currencies
 				case Country:
-					currencies = country.Currencies
+					id, currencies = country.ID, country.Currencies
+				}
+
+				loader := CountryLoaderFrom(p.Context)
+				if len(currencies) > 0 || len(id) == 0 || loader == nil {
+					return currencies, nil
 				}
 
-				return currencies, nil
+				load := loader.Currencies(p.Context, id)
+				return func() (interface{}, error) {
+					return load()
+				}, nil
 			},
 		},
 		"createdAt": &graphql.Field{
@@ -72,22 +86,30 @@ var (
 		"updatedAt": &graphql.Field{
 			Type: graphql.DateTime,
 		},
-		"provinces": &graphql.Field{
-			Type: graphql.NewList(RegionType(ProvinceNode)),
-			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-				var provinces []*Region
-
-				switch country := p.Source.(type) {
-				case Country:
-					provinces = country.Provinces
-				case *Country:
-					provinces = country.Provinces
-				}
+	}
 
-				return provinces, nil
+	// LocationType is the centroid coordinate, null when the coordinate isn't imported
+	LocationType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Location",
+		Description: "WGS-84 coordinate of the centroid",
+		Fields: graphql.Fields{
+			"lat": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Float),
+			},
+			"lng": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Float),
 			},
 		},
-	}
+	})
+
+	// GeoJSONScalar is the GeoJSON geometry object, only used as output
+	GeoJSONScalar = graphql.NewScalar(graphql.ScalarConfig{
+		Name:        "GeoJSON",
+		Description: "GeoJSON geometry object",
+		Serialize: func(value interface{}) interface{} {
+			return value
+		},
+	})
 
 	flagType = graphql.NewObject(graphql.ObjectConfig{
 		Name:        "Flag",
@@ -240,158 +262,384 @@ var (
 		"code": &graphql.ArgumentConfig{
 			Type: graphql.String,
 		},
+		"name": &graphql.ArgumentConfig{
+			Type:        graphql.String,
+			Description: "Name written with or without the administrative prefix or the old spelling, e.g. \"kab bandung\"",
+		},
 	}
 
-	ListRegionArgs = graphql.FieldConfigArgument{
-		"code": &graphql.ArgumentConfig{
-			Type: graphql.String,
+	// ListRegionArgs are the list arguments of any level, the lists of one level use LevelListArgs
+	ListRegionArgs = listRegionArgs(RegionWhereInput, UpperLevels())
+
+	ConnectionRegionArgs = ConnectionArgs(ListRegionArgs)
+	CountRegionArgs      = CountArgs(ListRegionArgs)
+
+	// LevelListArgs are the list arguments of the level by the node label, only the ancestors of the level filter it
+	LevelListArgs = levelListArgs()
+
+	// LevelConnectionArgs are the connection arguments of the level by the node label
+	LevelConnectionArgs = levelArgs(LevelListArgs, ConnectionArgs)
+
+	// LevelCountArgs are the count arguments of the level by the node label
+	LevelCountArgs = levelArgs(LevelListArgs, CountArgs)
+
+	AggregateRegionArgs = aggregateArgs(CountArgs(ListRegionArgs))
+
+	SearchRegionArgs = graphql.FieldConfigArgument{
+		"text": &graphql.ArgumentConfig{
+			Type:        graphql.NewNonNull(graphql.String),
+			Description: "Name or part of the name, misspelled words are matched with the closest name",
+		},
+		"levels": &graphql.ArgumentConfig{
+			Type:        graphql.NewList(graphql.NewNonNull(RegionLevelEnum)),
+			Description: "Administrative divisions to be searched, every level when it's empty",
+		},
+		"country": &graphql.ArgumentConfig{
+			Type: CountryInput,
 		},
 		"limit": &graphql.ArgumentConfig{
 			Type:         graphql.Int,
 			DefaultValue: config.Limit,
 		},
-		"offset": &graphql.ArgumentConfig{
-			Type:         graphql.Int,
-			DefaultValue: config.Offset,
+	}
+
+	RegionLevelEnum = graphql.NewEnum(graphql.EnumConfig{
+		Name:        "RegionLevel",
+		Description: "Level of administrative division",
+		Values:      levelValues(),
+	})
+
+	OrderDirectionEnum = graphql.NewEnum(graphql.EnumConfig{
+		Name:        "OrderDirection",
+		Description: "Direction of the list order",
+		Values: graphql.EnumValueConfigMap{
+			"ASC": &graphql.EnumValueConfig{
+				Value: provider.Ascending,
+			},
+			"DESC": &graphql.EnumValueConfig{
+				Value: provider.Descending,
+			},
 		},
-		"province": &graphql.ArgumentConfig{
-			Type: RegionInput(ProvinceNode),
+	})
+
+	RegionOrderInput = orderInput("Region", "administrative division", graphql.EnumValueConfigMap{
+		"NAME": &graphql.EnumValueConfig{
+			Value: "name",
 		},
-		"city": &graphql.ArgumentConfig{
-			Type: RegionInput(CityNode),
+		"CODE": &graphql.EnumValueConfig{
+			Value: "code",
 		},
-		"regency": &graphql.ArgumentConfig{
-			Type: RegionInput(RegencyNode),
+		"CREATED_AT": &graphql.EnumValueConfig{
+			Value: "createdAt",
 		},
-		"district": &graphql.ArgumentConfig{
-			Type: RegionInput(DistrictNode),
+		"UPDATED_AT": &graphql.EnumValueConfig{
+			Value: "updatedAt",
 		},
-		"country": &graphql.ArgumentConfig{
-			Type: CountryInput,
+	})
+
+	AddressCandidateType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "AddressCandidate",
+		Description: "Administrative divisions matched from the address, each level is nil when it's not matched",
+		Fields:      addressFields(),
+	})
+
+	ParseAddressArgs = graphql.FieldConfigArgument{
+		"text": &graphql.ArgumentConfig{
+			Type:        graphql.NewNonNull(graphql.String),
+			Description: "Free-text address, parts separated by comma are matched as one name",
+		},
+		"limit": &graphql.ArgumentConfig{
+			Type:         graphql.Int,
+			DefaultValue: 5,
+			Description:  "Maximum number of candidates, at most 20",
 		},
 	}
 
-	regionFields = graphql.Fields{
-		"id": &graphql.Field{
-			Type: scalar.UUID,
+	LocateArgs = graphql.FieldConfigArgument{
+		"lat": &graphql.ArgumentConfig{
+			Type:        graphql.NewNonNull(graphql.Float),
+			Description: "WGS-84 latitude",
 		},
-		"name": &graphql.Field{
-			Type: graphql.String,
-		},
-		"code": &graphql.Field{
-			Type: graphql.String,
+		"lng": &graphql.ArgumentConfig{
+			Type:        graphql.NewNonNull(graphql.Float),
+			Description: "WGS-84 longitude",
 		},
-		"createdAt": &graphql.Field{
-			Type: graphql.DateTime,
+	}
+
+	// NearbyArgs are the count arguments with the point, ordered by the distance instead of the order argument
+	NearbyArgs = nearArgs(graphql.FieldConfigArgument{
+		"radiusKm": &graphql.ArgumentConfig{
+			Type:        graphql.NewNonNull(graphql.Float),
+			Description: "Maximum great-circle distance in kilometers",
 		},
-		"updatedAt": &graphql.Field{
-			Type: graphql.DateTime,
+		"limit": &graphql.ArgumentConfig{
+			Type:         graphql.Int,
+			DefaultValue: config.Limit,
 		},
-		"provinces": &graphql.Field{
-			Type: graphql.NewList(RegionType(ProvinceNode)),
-			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-				var provinces []*Region
+	})
 
-				switch region := p.Source.(type) {
-				case *Region:
-					provinces = region.Provinces
-				case Region:
-					provinces = region.Provinces
-				}
+	NearestArgs = nearArgs(graphql.FieldConfigArgument{})
 
-				return provinces, nil
+	RegionValidationType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "RegionValidation",
+		Description: "Result of checking the supplied codes exist and belong to the supplied upper levels",
+		Fields: graphql.Fields{
+			"valid": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.Boolean),
+				Description: "Every code exists and every link is valid",
+			},
+			"regions": &graphql.Field{
+				Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+					Name:        "RegionCheck",
+					Description: "Supplied code of the administrative division level",
+					Fields: graphql.Fields{
+						"level": &graphql.Field{
+							Type: graphql.NewNonNull(RegionLevelEnum),
+						},
+						"code": &graphql.Field{
+							Type: graphql.NewNonNull(graphql.String),
+						},
+						"exists": &graphql.Field{
+							Type: graphql.NewNonNull(graphql.Boolean),
+						},
+					},
+				})),
+			},
+			"links": &graphql.Field{
+				Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+					Name:        "RegionLink",
+					Description: "Supplied child level and the nearest supplied upper level",
+					Fields: graphql.Fields{
+						"parent": &graphql.Field{
+							Type: graphql.NewNonNull(RegionLevelEnum),
+						},
+						"parentCode": &graphql.Field{
+							Type: graphql.NewNonNull(graphql.String),
+						},
+						"child": &graphql.Field{
+							Type: graphql.NewNonNull(RegionLevelEnum),
+						},
+						"childCode": &graphql.Field{
+							Type: graphql.NewNonNull(graphql.String),
+						},
+						"valid": &graphql.Field{
+							Type: graphql.NewNonNull(graphql.Boolean),
+						},
+						"actualParentCode": &graphql.Field{
+							Type:        graphql.String,
+							Description: "Code of the upper level the child belongs to, null when the child doesn't exist",
+						},
+					},
+				})),
 			},
 		},
-		"cities": &graphql.Field{
-			Type: graphql.NewList(RegionType(CityNode)),
-			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-				var cities []*Region
+	})
 
-				switch region := p.Source.(type) {
-				case *Region:
-					cities = region.Cities
-				case Region:
-					cities = region.Cities
-				}
+	ValidateRegionArgs = validateArgs()
 
-				return cities, nil
+	RegionCountType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "RegionCount",
+		Description: "Total of administrative divisions belong to the group",
+		Fields: graphql.Fields{
+			"group": &graphql.Field{
+				Type: graphql.NewObject(graphql.ObjectConfig{
+					Name:        "RegionGroup",
+					Description: "Administrative division used to group the count",
+					Fields: graphql.Fields{
+						"id": &graphql.Field{
+							Type: scalar.UUID,
+						},
+						"name": &graphql.Field{
+							Type: graphql.String,
+						},
+						"code": &graphql.Field{
+							Type: graphql.String,
+						},
+					},
+				}),
+			},
+			"total": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
 			},
 		},
-		"regencies": &graphql.Field{
-			Type: graphql.NewList(RegionType(RegencyNode)),
-			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-				var regencies []*Region
-
-				switch region := p.Source.(type) {
-				case *Region:
-					regencies = region.Regencies
-				case Region:
-					regencies = region.Regencies
-				}
+	})
 
-				return regencies, nil
+	StringFilterInput = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "StringFilter",
+		Description: "Filter operators for string field, all operators are combined with AND",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"equal": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"notEqual": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"contains": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"startsWith": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"endsWith": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"in": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewList(graphql.String),
+			},
+			"notIn": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewList(graphql.String),
+			},
+			"regex": &graphql.InputObjectFieldConfig{
+				Type:        graphql.String,
+				Description: "Case insensitive regular expression",
 			},
 		},
-		"districts": &graphql.Field{
-			Type: graphql.NewList(RegionType(DistrictNode)),
-			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-				var districts []*Region
-
-				switch region := p.Source.(type) {
-				case *Region:
-					districts = region.Districts
-				case Region:
-					districts = region.Districts
-				}
+	})
 
-				return districts, nil
+	DateTimeFilterInput = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "DateTimeFilter",
+		Description: "Filter operators for date time field, all operators are combined with AND",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"equal": &graphql.InputObjectFieldConfig{
+				Type: graphql.DateTime,
+			},
+			"notEqual": &graphql.InputObjectFieldConfig{
+				Type: graphql.DateTime,
+			},
+			"greaterThan": &graphql.InputObjectFieldConfig{
+				Type: graphql.DateTime,
+			},
+			"lessThan": &graphql.InputObjectFieldConfig{
+				Type: graphql.DateTime,
 			},
 		},
-		"villages": &graphql.Field{
-			Type: graphql.NewList(RegionType(VillageNode)),
-			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-				var villages []*Region
+	})
 
-				switch region := p.Source.(type) {
-				case *Region:
-					villages = region.Villages
-				case Region:
-					villages = region.Villages
-				}
+	// RegionWhereInput filters the administrative division of any level by every upper level,
+	// used by the fields with the level argument
+	RegionWhereInput = regionWhereInput("Region", UpperLevels())
 
-				return villages, nil
+	CountryWhereInput = whereInput(graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "CountryWhere",
+		Description: "Filter country by field operators, fields are combined with AND",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name": &graphql.InputObjectFieldConfig{
+				Type: StringFilterInput,
+			},
+			"dialCode": &graphql.InputObjectFieldConfig{
+				Type: StringFilterInput,
 			},
+			"ISO3166Alpha2": &graphql.InputObjectFieldConfig{
+				Type: StringFilterInput,
+			},
+			"ISO3166Alpha3": &graphql.InputObjectFieldConfig{
+				Type: StringFilterInput,
+			},
+			"ISO3166Numeric": &graphql.InputObjectFieldConfig{
+				Type: StringFilterInput,
+			},
+			"createdAt": &graphql.InputObjectFieldConfig{
+				Type: DateTimeFilterInput,
+			},
+			"updatedAt": &graphql.InputObjectFieldConfig{
+				Type: DateTimeFilterInput,
+			},
+		},
+	}))
+
+	regionFields = graphql.Fields{
+		"id": &graphql.Field{
+			Type: scalar.UUID,
+		},
+		"name": &graphql.Field{
+			Type: graphql.String,
+		},
+		"code": &graphql.Field{
+			Type: graphql.String,
+		},
+		"location": &graphql.Field{
+			Type: LocationType,
+		},
+		"distanceKm": distanceField(),
+		"geometry":   geometryField(),
+		"createdAt": &graphql.Field{
+			Type: graphql.DateTime,
+		},
+		"updatedAt": &graphql.Field{
+			Type: graphql.DateTime,
 		},
 	}
 
-	ProvinceType = graphql.NewObject(graphql.ObjectConfig{
-		Name:        ProvinceNode,
-		Description: "Province administrative division",
-		Fields:      regionFields,
-	})
+	// LevelInputs are the input types of the upper levels by the node label, used to filter the lower levels
+	LevelInputs = levelInputs()
 
-	CityType = graphql.NewObject(graphql.ObjectConfig{
-		Name:        CityNode,
-		Description: "City administrative division",
-		Fields:      regionFields,
+	// AdministrativeDivisionInterface is implemented by every level of administrative division,
+	// the type is resolved from the region level
+	AdministrativeDivisionInterface = graphql.NewInterface(graphql.InterfaceConfig{
+		Name:        "AdministrativeDivision",
+		Description: "Fields shared by every level of administrative division",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: scalar.UUID,
+			},
+			"name": &graphql.Field{
+				Type: graphql.String,
+			},
+			"code": &graphql.Field{
+				Type: graphql.String,
+			},
+			"location": &graphql.Field{
+				Type: LocationType,
+			},
+			"distanceKm": distanceField(),
+			"geometry":   geometryField(),
+			"createdAt": &graphql.Field{
+				Type: graphql.DateTime,
+			},
+			"updatedAt": &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
 	})
 
-	RegencyType = graphql.NewObject(graphql.ObjectConfig{
-		Name:        RegencyNode,
-		Description: "Regency administrative division",
-		Fields:      regionFields,
-	})
+	// LevelTypes are the object types of the administrative division levels by the node label
+	LevelTypes = levelTypes()
+
+	// AncestorFields are fields of administrative division resolved from the ancestors
+	AncestorFields = ancestorFieldNames()
 
-	DistrictType = graphql.NewObject(graphql.ObjectConfig{
-		Name:        DistrictNode,
-		Description: "District administrative division",
-		Fields:      regionFields,
+	AncestorUnion = graphql.NewUnion(graphql.UnionConfig{
+		Name:        "Ancestor",
+		Description: "Country or upper administrative division",
+		Types:       ancestorTypes(),
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			if _, ok := p.Value.(*Country); ok {
+				return CountryType
+			}
+			return divisionType(p)
+		},
 	})
 
-	VillageType = graphql.NewObject(graphql.ObjectConfig{
-		Name:        VillageNode,
-		Description: "Village administrative division",
-		Fields:      regionFields,
+	// LevelConnectionTypes are the connection types of the administrative division levels by the node label
+	LevelConnectionTypes = levelConnectionTypes()
+
+	PageInfoType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "PageInfo",
+		Description: "Information about pagination in a connection",
+		Fields: graphql.Fields{
+			"hasNextPage": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+			},
+			"hasPreviousPage": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+			},
+			"startCursor": &graphql.Field{
+				Type: graphql.String,
+			},
+			"endCursor": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
 	})
 
 	CurrencyType = graphql.NewObject(graphql.ObjectConfig{
@@ -401,7 +649,7 @@ var (
 	})
 
 	CurrencyInput = graphql.NewInputObject(graphql.InputObjectConfig{
-		Name:        fmt.Sprintf("Currency_%s", random.String(5, random.Alphabetic)),
+		Name:        "CurrencyInput",
 		Description: "Currency input arguments",
 		Fields: graphql.InputObjectConfigFieldMap{
 			"id": &graphql.InputObjectFieldConfig{
@@ -428,8 +676,10 @@ var (
 		Fields:      countryField,
 	})
 
+	CountryConnectionType = ConnectionType(CountryType)
+
 	CountryInput = graphql.NewInputObject(graphql.InputObjectConfig{
-		Name:        "Country",
+		Name:        "CountryInput",
 		Description: "Country input arguments",
 		Fields: graphql.InputObjectConfigFieldMap{
 			"id": &graphql.InputObjectFieldConfig{
@@ -456,6 +706,30 @@ var (
 		},
 	})
 
+	CountryOrderInput = orderInput("Country", "country", graphql.EnumValueConfigMap{
+		"NAME": &graphql.EnumValueConfig{
+			Value: "name",
+		},
+		"ISO3166_ALPHA2": &graphql.EnumValueConfig{
+			Value: "ISO3166Alpha2",
+		},
+		"ISO3166_ALPHA3": &graphql.EnumValueConfig{
+			Value: "ISO3166Alpha3",
+		},
+		"ISO3166_NUMERIC": &graphql.EnumValueConfig{
+			Value: "ISO3166Numeric",
+		},
+		"DIAL_CODE": &graphql.EnumValueConfig{
+			Value: "dialCode",
+		},
+		"CREATED_AT": &graphql.EnumValueConfig{
+			Value: "createdAt",
+		},
+		"UPDATED_AT": &graphql.EnumValueConfig{
+			Value: "updatedAt",
+		},
+	})
+
 	CountryArgs = graphql.FieldConfigArgument{
 		"id": &graphql.ArgumentConfig{
 			Type: scalar.UUID,
@@ -486,47 +760,831 @@ var (
 		"currencies": &graphql.ArgumentConfig{
 			Type: graphql.NewList(CurrencyInput),
 		},
+		"where": &graphql.ArgumentConfig{
+			Type: CountryWhereInput,
+		},
+		"orderBy": &graphql.ArgumentConfig{
+			Type:        graphql.NewList(graphql.NewNonNull(CountryOrderInput)),
+			Description: "Fields to order the list, ordered by name when it's empty",
+		},
 	}
+
+	ConnectionCountryArgs = ConnectionArgs(ListCountryArgs)
+	CountCountryArgs      = CountArgs(ListCountryArgs)
 )
 
-func RegionInput(name string) *graphql.InputObject {
+// Children and ancestor fields refer to the administrative division types, added after the types are initialized
+func init() {
+	AdministrativeDivisionInterface.ResolveType = divisionType
+	AdministrativeDivisionInterface.AddFieldConfig("path", &graphql.Field{
+		Type:        graphql.NewList(AncestorUnion),
+		Description: "Breadcrumb from the country to the parent",
+	})
+
+	for _, level := range Levels {
+		object := LevelTypes[level.Label]
+		childFields(object)
+		ancestorFields(object)
+		neighbourField(object)
+	}
+
+	for _, level := range TopLevels() {
+		CountryType.AddFieldConfig(level.Plural, topLevelField(level))
+	}
+
+	CountryType.AddFieldConfig("neighbours", &graphql.Field{
+		Type:        graphql.NewList(CountryType),
+		Description: "Countries sharing a land border ordered by name",
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			var country *Country
+
+			switch c := p.Source.(type) {
+			case Country:
+				country = &c
+			case *Country:
+				country = c
+			}
+
+			loader := CountryLoaderFrom(p.Context)
+			if country == nil || loader == nil || len(country.ID) == 0 {
+				return nil, nil
+			}
+
+			load := loader.Neighbours(p.Context, country.ID)
+			return func() (interface{}, error) {
+				return load()
+			}, nil
+		},
+	})
+}
+
+// topLevelField resolves the administrative divisions of the level with the country as the parent
+func topLevelField(level *Level) *graphql.Field {
+	node := level.Label
+
+	return &graphql.Field{
+		Type: graphql.NewList(LevelTypes[node]),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			var country *Country
+
+			switch c := p.Source.(type) {
+			case Country:
+				country = &c
+			case *Country:
+				country = c
+			}
+
+			if country == nil {
+				return nil, nil
+			}
+
+			descend := func(regions []*Region) []*Region {
+				for _, region := range regions {
+					region.Ancestors = &Ancestors{ID: region.ID, Country: country}
+				}
+				return regions
+			}
+
+			loader := RegionLoaderFrom(p.Context)
+			if children := country.Children(node); len(children) > 0 || len(country.ID) == 0 || loader == nil {
+				return descend(children), nil
+			}
+
+			load := loader.Children(p.Context, CountryNode, country.ID, node)
+			return func() (interface{}, error) {
+				regions, err := load()
+				if err != nil {
+					return nil, err
+				}
+				return descend(regions), nil
+			}, nil
+		},
+	}
+}
+
+// addressFields are the matched administrative division of every level and the country of the address candidate
+func addressFields() graphql.Fields {
+	fields := graphql.Fields{
+		"confidence": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.Float),
+			Description: "Score from 0 to 1 of the address parts matched by the administrative divisions",
+		},
+		"country": &graphql.Field{
+			Type: CountryType,
+		},
+	}
+	for _, level := range Levels {
+		fields[level.Field()] = addressField(LevelTypes[level.Label])
+	}
+
+	return fields
+}
+
+// addressField resolves the matched administrative division of the type level from the address candidate
+func addressField(object *graphql.Object) *graphql.Field {
+	level := object.Name()
+
+	return &graphql.Field{
+		Type: object,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			candidate, ok := p.Source.(*AddressCandidate)
+			if !ok {
+				return nil, nil
+			}
+
+			if region := candidate.Region(level); region != nil {
+				return region, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
+// childFields adds the fields of the child levels to the region type, e.g. cities and regencies of province
+func childFields(object *graphql.Object) {
+	for _, level := range ChildLevels(object.Name()) {
+		child := LevelTypes[level.Label]
+		node := level.Label
+		object.AddFieldConfig(level.Plural, &graphql.Field{
+			Type: graphql.NewList(child),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				region := regionOf(p.Source)
+				if region == nil {
+					return nil, nil
+				}
+
+				level := p.Info.ParentType.Name()
+
+				children := region.Children(node)
+				if children != nil || len(region.ID) == 0 {
+					region.Descend(level, children)
+					return children, nil
+				}
+
+				loader := RegionLoaderFrom(p.Context)
+				if loader == nil {
+					return children, nil
+				}
+
+				// Children not fetched with the parent are loaded with the other parents of the same level
+				load := loader.Children(p.Context, level, region.ID, node)
+				return func() (interface{}, error) {
+					children, err := load()
+					if err != nil {
+						return nil, err
+					}
+
+					region.Descend(level, children)
+					return children, nil
+				}, nil
+			},
+		})
+	}
+}
+
+// geometryField returns the boundary field of the administrative division,
+// the boundaries of the same level in one request are loaded together
+func geometryField() *graphql.Field {
+	return &graphql.Field{
+		Type:        GeoJSONScalar,
+		Description: "Boundary as GeoJSON, null when the boundary isn't imported",
+		Args: graphql.FieldConfigArgument{
+			"simplify": &graphql.ArgumentConfig{
+				Type:        graphql.Float,
+				Description: "Douglas-Peucker tolerance in degrees, the full boundary is returned when it's empty",
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			tolerance, _ := p.Args["simplify"].(float64)
+			if tolerance < 0 {
+				return nil, config.NewError(http.StatusPreconditionFailed, "simplify", "simplify can't be negative")
+			}
+
+			region := regionOf(p.Source)
+			loader := RegionLoaderFrom(p.Context)
+			if region == nil || loader == nil || len(region.ID) == 0 {
+				return nil, nil
+			}
+
+			load := loader.Boundary(p.Context, p.Info.ParentType.Name(), region.ID)
+			return func() (interface{}, error) {
+				boundary, err := load()
+				if err != nil {
+					return nil, err
+				}
+				if boundary == nil {
+					return nil, nil
+				}
+
+				geometry, err := ParseGeometry([]byte(boundary.Geometry))
+				if err != nil {
+					return nil, config.NewError(http.StatusInternalServerError, "", err.Error())
+				}
+
+				return geometry.Simplify(tolerance), nil
+			}, nil
+		},
+	}
+}
+
+// distanceField returns the distance to the point of the nearby query in kilometers, null for other queries
+func distanceField() *graphql.Field {
+	return &graphql.Field{
+		Type:        graphql.Float,
+		Description: "Great-circle distance in kilometers to the point of nearby and nearest queries",
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			region := regionOf(p.Source)
+			if region == nil || region.Distance == nil {
+				return nil, nil
+			}
+
+			return *region.Distance / 1000, nil
+		},
+	}
+}
+
+// neighbourField adds the administrative divisions of the same level sharing a border to the region type
+func neighbourField(object *graphql.Object) {
+	object.AddFieldConfig("neighbours", &graphql.Field{
+		Type:        graphql.NewList(object),
+		Description: "Administrative divisions of the same level sharing a border ordered by name",
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			region := regionOf(p.Source)
+			loader := RegionLoaderFrom(p.Context)
+			if region == nil || loader == nil || len(region.ID) == 0 {
+				return nil, nil
+			}
+
+			load := loader.Neighbours(p.Context, p.Info.ParentType.Name(), region.ID)
+			return func() (interface{}, error) {
+				return load()
+			}, nil
+		},
+	})
+}
+
+// ancestorFields adds the country and upper administrative division fields to the region type
+func ancestorFields(object *graphql.Object) {
+	object.AddFieldConfig("parent", &graphql.Field{
+		Type:        AncestorUnion,
+		Description: "Closest upper administrative division, country is parent of the top level",
+		Resolve: ancestorResolver(func(region *Region) interface{} {
+			return region.Parent()
+		}),
+	})
+
+	object.AddFieldConfig("country", &graphql.Field{
+		Type:        CountryType,
+		Description: "Country the administrative division belongs to",
+		Resolve: ancestorResolver(func(region *Region) interface{} {
+			if region.Ancestors == nil || region.Ancestors.Country == nil {
+				return nil
+			}
+			return region.Ancestors.Country
+		}),
+	})
+
+	object.AddFieldConfig("path", &graphql.Field{
+		Type:        graphql.NewList(AncestorUnion),
+		Description: "Breadcrumb from the country to the parent",
+		Resolve: ancestorResolver(func(region *Region) interface{} {
+			return region.Breadcrumb()
+		}),
+	})
+
+	for _, level := range UpperLevels() {
+		node := level.Label
+		object.AddFieldConfig(level.Field(), &graphql.Field{
+			Type:        LevelTypes[node],
+			Description: fmt.Sprintf("%s the administrative division belongs to", node),
+			Resolve: ancestorResolver(func(region *Region) interface{} {
+				if ancestor := region.Ancestor(node); ancestor != nil {
+					return ancestor
+				}
+				return nil
+			}),
+		})
+	}
+}
+
+// ancestorResolver resolves the field from the ancestors of the region,
+// ancestors not fetched with the region are loaded with the other regions of the same level
+func ancestorResolver(resolve func(region *Region) interface{}) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		region := regionOf(p.Source)
+		if region == nil {
+			return nil, nil
+		}
+
+		loader := RegionLoaderFrom(p.Context)
+		if region.Ancestors != nil || loader == nil || len(region.ID) == 0 {
+			return resolve(region), nil
+		}
+
+		load := loader.Ancestors(p.Context, p.Info.ParentType.Name(), region.ID)
+		return func() (interface{}, error) {
+			ancestors, err := load()
+			if err != nil {
+				return nil, err
+			}
+
+			if ancestors == nil {
+				ancestors = &Ancestors{ID: region.ID}
+			}
+			region.Ancestors = ancestors
+
+			return resolve(region), nil
+		}, nil
+	}
+}
+
+// Project selects the properties and child nodes requested under the path of the resolved field,
+// e.g. path edges, node for connection, the ancestor fields are loaded separately and not projected
+func Project(info graphql.ResolveInfo, query *provider.Query, path ...string) *provider.Query {
+	for _, field := range info.FieldASTs {
+		project(field.SelectionSet, info.Fragments, query, path)
+	}
+
+	return query
+}
+
+func project(set *ast.SelectionSet, fragments map[string]ast.Definition, query *provider.Query, path []string) {
+	if set == nil {
+		return
+	}
+
+	for _, selection := range set.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			field := selection.Name.Value
+
+			if len(path) > 0 {
+				if field == path[0] {
+					project(selection.SelectionSet, fragments, query, path[1:])
+				}
+				continue
+			}
+
+			if node, ok := ChildNodes[field]; ok {
+				project(selection.SelectionSet, fragments, query.ExpansionOf(field, node), nil)
+				continue
+			}
+
+			if RegionProperties[field] {
+				query.Select(field)
+			}
+		case *ast.InlineFragment:
+			project(selection.SelectionSet, fragments, query, path)
+		case *ast.FragmentSpread:
+			if fragment, ok := fragments[selection.Name.Value].(*ast.FragmentDefinition); ok {
+				project(fragment.SelectionSet, fragments, query, path)
+			}
+		}
+	}
+}
+
+// Selected checks whether one of the fields is requested under the selection set of the resolved field
+func Selected(info graphql.ResolveInfo, fields ...string) bool {
+	for _, field := range info.FieldASTs {
+		if selected(field.SelectionSet, info.Fragments, fields) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func selected(set *ast.SelectionSet, fragments map[string]ast.Definition, fields []string) bool {
+	if set == nil {
+		return false
+	}
+
+	for _, selection := range set.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			for _, field := range fields {
+				if selection.Name.Value == field {
+					return true
+				}
+			}
+
+			if selected(selection.SelectionSet, fragments, fields) {
+				return true
+			}
+		case *ast.InlineFragment:
+			if selected(selection.SelectionSet, fragments, fields) {
+				return true
+			}
+		case *ast.FragmentSpread:
+			if fragment, ok := fragments[selection.Name.Value].(*ast.FragmentDefinition); ok {
+				if selected(fragment.SelectionSet, fragments, fields) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// divisionType resolves the administrative division type from the region level
+func divisionType(p graphql.ResolveTypeParams) *graphql.Object {
+	region := regionOf(p.Value)
+	if region == nil {
+		return nil
+	}
+
+	return LevelTypes[region.Level]
+}
+
+// levelTypes creates the object type of every level, the fields are copied so every type has own child fields
+func levelTypes() map[string]*graphql.Object {
+	types := make(map[string]*graphql.Object, len(Levels))
+	for _, level := range Levels {
+		types[level.Label] = graphql.NewObject(graphql.ObjectConfig{
+			Name:        level.Label,
+			Description: level.Description,
+			Fields:      copyFields(regionFields),
+			Interfaces:  []*graphql.Interface{AdministrativeDivisionInterface},
+		})
+	}
+
+	return types
+}
+
+func levelConnectionTypes() map[string]*graphql.Object {
+	types := make(map[string]*graphql.Object, len(Levels))
+	for _, level := range Levels {
+		types[level.Label] = ConnectionType(LevelTypes[level.Label])
+	}
+
+	return types
+}
+
+func levelInputs() map[string]*graphql.InputObject {
+	inputs := map[string]*graphql.InputObject{}
+	for _, level := range UpperLevels() {
+		inputs[level.Label] = regionInput(level.Label)
+	}
+
+	return inputs
+}
+
+// levelValues are the enum values of the levels, e.g. PROVINCE
+func levelValues() graphql.EnumValueConfigMap {
+	values := make(graphql.EnumValueConfigMap, len(Levels))
+	for _, level := range Levels {
+		values[strings.ToUpper(level.Label)] = &graphql.EnumValueConfig{
+			Value: level.Label,
+		}
+	}
+
+	return values
+}
+
+// listRegionArgs returns the list arguments filtered by the where input and the input argument of the upper levels
+func listRegionArgs(where *graphql.InputObject, levels []*Level) graphql.FieldConfigArgument {
+	args := graphql.FieldConfigArgument{
+		"code": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
+		"limit": &graphql.ArgumentConfig{
+			Type:         graphql.Int,
+			DefaultValue: config.Limit,
+		},
+		"offset": &graphql.ArgumentConfig{
+			Type:         graphql.Int,
+			DefaultValue: config.Offset,
+		},
+		"country": &graphql.ArgumentConfig{
+			Type: CountryInput,
+		},
+		"where": &graphql.ArgumentConfig{
+			Type: where,
+		},
+		"orderBy": &graphql.ArgumentConfig{
+			Type:        graphql.NewList(graphql.NewNonNull(RegionOrderInput)),
+			Description: "Fields to order the list, ordered by name when it's empty",
+		},
+	}
+
+	for _, level := range levels {
+		args[level.Field()] = &graphql.ArgumentConfig{
+			Type: LevelInputs[level.Label],
+		}
+	}
+
+	return args
+}
+
+// levelListArgs returns the list arguments of every level, the upper level without path to the level can't filter it,
+// e.g. provinces have no district argument
+func levelListArgs() map[string]graphql.FieldConfigArgument {
+	args := make(map[string]graphql.FieldConfigArgument, len(Levels))
+	for _, level := range Levels {
+		ancestors := AncestorLevels(level.Label)
+		args[level.Label] = listRegionArgs(regionWhereInput(level.Label, ancestors), ancestors)
+	}
+
+	return args
+}
+
+// levelArgs converts the list arguments of every level, e.g. to the connection arguments
+func levelArgs(list map[string]graphql.FieldConfigArgument, convert func(graphql.FieldConfigArgument) graphql.FieldConfigArgument) map[string]graphql.FieldConfigArgument {
+	args := make(map[string]graphql.FieldConfigArgument, len(list))
+	for label, arg := range list {
+		args[label] = convert(arg)
+	}
+
+	return args
+}
+
+// validateArgs are the code argument of every level
+func validateArgs() graphql.FieldConfigArgument {
+	args := make(graphql.FieldConfigArgument, len(Levels))
+	for _, level := range Levels {
+		args[level.Field()] = &graphql.ArgumentConfig{
+			Type: graphql.String,
+		}
+	}
+
+	return args
+}
+
+func ancestorFieldNames() []string {
+	fields := []string{"parent"}
+	for _, level := range UpperLevels() {
+		fields = append(fields, level.Field())
+	}
+
+	return append(fields, "country", "path")
+}
+
+func ancestorTypes() []*graphql.Object {
+	types := []*graphql.Object{CountryType}
+	for _, level := range UpperLevels() {
+		types = append(types, LevelTypes[level.Label])
+	}
+
+	return types
+}
+
+// copyFields returns new map of the fields, so the fields added to one type aren't added to the other types
+func copyFields(fields graphql.Fields) graphql.Fields {
+	copied := make(graphql.Fields, len(fields))
+	for name, field := range fields {
+		copied[name] = field
+	}
+
+	return copied
+}
+
+func regionOf(source interface{}) *Region {
+	switch region := source.(type) {
+	case *Region:
+		return region
+	case Region:
+		return &region
+	}
+
+	return nil
+}
+
+// ConnectionType creates relay connection and edge type of the node type
+func ConnectionType(node *graphql.Object) *graphql.Object {
+	edge := graphql.NewObject(graphql.ObjectConfig{
+		Name:        fmt.Sprintf("%sEdge", node.Name()),
+		Description: fmt.Sprintf("An edge in %s connection", node.Name()),
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+			"node": &graphql.Field{
+				Type: node,
+			},
+		},
+	})
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name:        fmt.Sprintf("%sConnection", node.Name()),
+		Description: fmt.Sprintf("Relay connection of %s ordered by name", node.Name()),
+		Fields: graphql.Fields{
+			"edges": &graphql.Field{
+				Type: graphql.NewList(edge),
+			},
+			"pageInfo": &graphql.Field{
+				Type: graphql.NewNonNull(PageInfoType),
+			},
+			"totalCount": &graphql.Field{
+				Type:        graphql.Int,
+				Description: "Total nodes match the filters regardless of the page",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					connection, ok := p.Source.(*Connection)
+					if !ok || connection.TotalCount == nil {
+						return nil, nil
+					}
+
+					return connection.TotalCount(p.Context)
+				},
+			},
+		},
+	})
+}
+
+// ConnectionArgs replaces limit and offset of list arguments with relay pagination arguments
+func ConnectionArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	connection := CountArgs(args)
+	if orderBy, ok := args["orderBy"]; ok {
+		connection["orderBy"] = orderBy
+	}
+	connection["first"] = &graphql.ArgumentConfig{
+		Type: graphql.Int,
+	}
+	connection["after"] = &graphql.ArgumentConfig{
+		Type: graphql.String,
+	}
+	connection["last"] = &graphql.ArgumentConfig{
+		Type: graphql.Int,
+	}
+	connection["before"] = &graphql.ArgumentConfig{
+		Type: graphql.String,
+	}
+
+	return connection
+}
+
+// CountArgs returns list arguments without limit, offset and order
+func CountArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	count := graphql.FieldConfigArgument{}
+
+	for key, arg := range args {
+		if key == "limit" || key == "offset" || key == "orderBy" {
+			continue
+		}
+		count[key] = arg
+	}
+
+	return count
+}
+
+// orderInput creates the order input of the type, the field enum only has the sortable properties of the type
+func orderInput(name, description string, fields graphql.EnumValueConfigMap) *graphql.InputObject {
 	return graphql.NewInputObject(graphql.InputObjectConfig{
-		Name:        fmt.Sprintf("%s_%s", name, random.New().String(5, random.Alphabetic)),
-		Description: fmt.Sprintf("Region type of %s", name),
+		Name:        name + "Order",
+		Description: fmt.Sprintf("Field and direction to order the %s list", description),
 		Fields: graphql.InputObjectConfigFieldMap{
-			"id": &graphql.InputObjectFieldConfig{
-				Type: scalar.UUID,
+			"field": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.NewEnum(graphql.EnumConfig{
+					Name:        name + "OrderField",
+					Description: fmt.Sprintf("Sortable field of %s", description),
+					Values:      fields,
+				})),
 			},
+			"direction": &graphql.InputObjectFieldConfig{
+				Type:        OrderDirectionEnum,
+				Description: "Ascending when it's empty",
+			},
+		},
+	})
+}
+
+// Order adds the orderings of the orderBy argument to the query, ordered by name ascending when it's empty
+func Order(query *provider.Query, orderBy interface{}) *provider.Query {
+	orders, _ := orderBy.([]interface{})
+
+	fields := make(map[string]bool, len(orders))
+	for _, order := range orders {
+		val, ok := order.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		field, _ := val["field"].(string)
+		direction, _ := val["direction"].(string)
+		if len(direction) < 1 {
+			direction = provider.Ascending
+		}
+		if len(field) < 1 || fields[field] {
+			continue
+		}
+
+		fields[field] = true
+		query.Ordering(field, direction)
+	}
+
+	if len(fields) < 1 {
+		query.Ordering("name", provider.Ascending)
+	}
+
+	return query
+}
+
+// aggregateArgs adds the counted and the group level to count arguments
+func aggregateArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	args["level"] = &graphql.ArgumentConfig{
+		Type:        graphql.NewNonNull(RegionLevelEnum),
+		Description: "Administrative division to be counted",
+	}
+	args["groupBy"] = &graphql.ArgumentConfig{
+		Type:        graphql.NewNonNull(RegionLevelEnum),
+		Description: "Upper administrative division to group the count",
+	}
+
+	return args
+}
+
+// nearArgs adds the point and the level to the count arguments of the regions
+func nearArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	for key, arg := range CountArgs(ListRegionArgs) {
+		args[key] = arg
+	}
+	args["lat"] = &graphql.ArgumentConfig{
+		Type:        graphql.NewNonNull(graphql.Float),
+		Description: "WGS-84 latitude",
+	}
+	args["lng"] = &graphql.ArgumentConfig{
+		Type:        graphql.NewNonNull(graphql.Float),
+		Description: "WGS-84 longitude",
+	}
+	args["level"] = &graphql.ArgumentConfig{
+		Type:        graphql.NewNonNull(RegionLevelEnum),
+		Description: "Administrative division to be returned, regions without location are skipped",
+	}
+
+	return args
+}
+
+// whereInput adds and, or and not group fields to the where input
+func whereInput(input *graphql.InputObject) *graphql.InputObject {
+	input.AddFieldConfig("and", &graphql.InputObjectFieldConfig{
+		Type:        graphql.NewList(graphql.NewNonNull(input)),
+		Description: "All conditions must match",
+	})
+	input.AddFieldConfig("or", &graphql.InputObjectFieldConfig{
+		Type:        graphql.NewList(graphql.NewNonNull(input)),
+		Description: "At least one condition must match",
+	})
+	input.AddFieldConfig("not", &graphql.InputObjectFieldConfig{
+		Type:        input,
+		Description: "Condition must not match",
+	})
+
+	return input
+}
+
+// regionWhereInput creates the where input of the administrative division with the group fields and filters
+// for the upper levels, type name must be unique in the schema
+func regionWhereInput(name string, levels []*Level) *graphql.InputObject {
+	input := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        name + "Where",
+		Description: "Filter administrative division by field operators, fields are combined with AND",
+		Fields: graphql.InputObjectConfigFieldMap{
 			"name": &graphql.InputObjectFieldConfig{
-				Type: graphql.String,
+				Type: StringFilterInput,
 			},
 			"code": &graphql.InputObjectFieldConfig{
-				Type: graphql.String,
+				Type: StringFilterInput,
+			},
+			"createdAt": &graphql.InputObjectFieldConfig{
+				Type: DateTimeFilterInput,
+			},
+			"updatedAt": &graphql.InputObjectFieldConfig{
+				Type: DateTimeFilterInput,
 			},
 		},
 	})
+
+	for _, level := range levels {
+		key := level.Field()
+		input.AddFieldConfig(key, &graphql.InputObjectFieldConfig{
+			Type:        input,
+			Description: fmt.Sprintf("Filter by %s the administrative division belongs to", key),
+		})
+	}
+
+	input.AddFieldConfig("country", &graphql.InputObjectFieldConfig{
+		Type:        CountryWhereInput,
+		Description: "Filter by country the administrative division belongs to",
+	})
+
+	return whereInput(input)
 }
 
-func RegionType(name string) *graphql.Object {
-	return graphql.NewObject(graphql.ObjectConfig{
-		Name:        fmt.Sprintf("%s_%s", name, random.New().String(5, random.Alphabetic)),
-		Description: fmt.Sprintf("Region type of %s", name),
-		Fields: graphql.Fields{
-			"id": &graphql.Field{
+// regionInput creates input type of the administrative division, type name must be unique in the schema
+func regionInput(name string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        fmt.Sprintf("%sInput", name),
+		Description: fmt.Sprintf("%s input arguments", name),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"id": &graphql.InputObjectFieldConfig{
 				Type: scalar.UUID,
 			},
-			"name": &graphql.Field{
+			"name": &graphql.InputObjectFieldConfig{
 				Type: graphql.String,
 			},
-			"code": &graphql.Field{
+			"code": &graphql.InputObjectFieldConfig{
 				Type: graphql.String,
 			},
-			"createdAt": &graphql.Field{
-				Type: graphql.DateTime,
-			},
-			"updatedAt": &graphql.Field{
-				Type: graphql.DateTime,
-			},
 		},
 	})
 }
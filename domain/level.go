@@ -0,0 +1,343 @@
+package domain
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+type (
+	// Level is one administrative division level, the label is the node label and the GraphQL type name,
+	// the plural is the list field and in uppercase the relationship type from the parents, e.g. PROVINCES
+	Level struct {
+		Label       string
+		Plural      string
+		Description string
+		// Parents are the labels of the upper levels, Country is the parent of the top level
+		Parents []string
+	}
+
+	// CountryHierarchy is the administrative division levels of the country ordered from the upper level
+	CountryHierarchy struct {
+		// Country is the ISO 3166 alpha-2 of the country
+		Country string
+		Levels  []*Level
+	}
+)
+
+const (
+	// envHierarchyFile is the path of the hierarchy file replacing DefaultHierarchy, e.g. hierarchy.yaml
+	envHierarchyFile = "HIERARCHY_FILE"
+
+	// DefaultHierarchy is the administrative division levels used when HIERARCHY_FILE is empty,
+	// hierarchy.yaml has the same levels as the example of the file
+	DefaultHierarchy = `# Administrative division levels of every country ordered from the upper level, Country is the parent of the top level.
+# The label is the node label and the GraphQL type, the plural is the list field and in uppercase the relationship type.
+hierarchies:
+  - country: ID
+    levels:
+      - label: Province
+        plural: provinces
+        parents: [Country]
+      - label: City
+        plural: cities
+        parents: [Province]
+      - label: Regency
+        plural: regencies
+        parents: [Province]
+      - label: District
+        plural: districts
+        parents: [City, Regency]
+      - label: Village
+        plural: villages
+        parents: [District]
+`
+)
+
+var (
+	// Hierarchies are the administrative division levels of every country, adding a country only needs the levels,
+	// the node labels, GraphQL types, fields, arguments and relationships are created from the levels, the countries
+	// can share the label of the same level, e.g. District, the parents of the shared level are merged
+	Hierarchies, Levels, hierarchyErr = loadLevels()
+
+	levelLabel  = regexp.MustCompile(`^[A-Z][A-Za-z]*$`)
+	levelPlural = regexp.MustCompile(`^[a-z]+$`)
+)
+
+// Field returns the singular field and the argument of the level, e.g. province
+func (l *Level) Field() string {
+	return strings.ToLower(l.Label)
+}
+
+// Relationship returns the relationship type from the parents to the level
+func (l *Level) Relationship() string {
+	return strings.ToUpper(l.Plural)
+}
+
+// LevelOf returns the level with the label, nil when the label isn't a level
+func LevelOf(label string) *Level {
+	for _, level := range Levels {
+		if level.Label == label {
+			return level
+		}
+	}
+
+	return nil
+}
+
+// UpperLevels returns the levels with child levels ordered from the upper level, only these are the ancestors
+func UpperLevels() []*Level {
+	parents := map[string]bool{}
+	for _, level := range Levels {
+		for _, parent := range level.Parents {
+			parents[parent] = true
+		}
+	}
+
+	var levels []*Level
+	for _, level := range Levels {
+		if parents[level.Label] {
+			levels = append(levels, level)
+		}
+	}
+
+	return levels
+}
+
+// ChildLevels returns the levels with the label as one of the parents ordered from the upper level
+func ChildLevels(label string) []*Level {
+	var levels []*Level
+	for _, level := range Levels {
+		if contains(level.Parents, label) {
+			levels = append(levels, level)
+		}
+	}
+
+	return levels
+}
+
+// AncestorLevels returns the levels the label descends from ordered from the upper level, the country isn't a level
+func AncestorLevels(label string) []*Level {
+	ancestors := map[string]bool{}
+
+	var walk func(label string)
+	walk = func(label string) {
+		level := LevelOf(label)
+		if level == nil {
+			return
+		}
+
+		for _, parent := range level.Parents {
+			if !ancestors[parent] {
+				ancestors[parent] = true
+				walk(parent)
+			}
+		}
+	}
+	walk(label)
+
+	var levels []*Level
+	for _, level := range Levels {
+		if ancestors[level.Label] {
+			levels = append(levels, level)
+		}
+	}
+
+	return levels
+}
+
+// TopLevels returns the levels with the country as one of the parents
+func TopLevels() []*Level {
+	return ChildLevels(CountryNode)
+}
+
+// MergeLevels validates the hierarchies and merges the levels with the same label, the parents of every level
+// must be Country or defined before the level in the same country, the merged levels are ordered by the depth
+// from the country
+func MergeLevels(hierarchies []*CountryHierarchy) ([]*Level, error) {
+	var levels []*Level
+	merged := map[string]*Level{}
+	plurals := map[string]string{}
+
+	for _, hierarchy := range hierarchies {
+		defined := map[string]bool{CountryNode: true}
+
+		for _, level := range hierarchy.Levels {
+			if !levelLabel.MatchString(level.Label) {
+				return nil, fmt.Errorf("%s: invalid level label %q", hierarchy.Country, level.Label)
+			}
+			if !levelPlural.MatchString(level.Plural) {
+				return nil, fmt.Errorf("%s: invalid plural %q of %s", hierarchy.Country, level.Plural, level.Label)
+			}
+			if defined[level.Label] {
+				return nil, fmt.Errorf("%s: duplicate level %s", hierarchy.Country, level.Label)
+			}
+			if other, ok := plurals[level.Plural]; ok && other != level.Label {
+				return nil, fmt.Errorf("%s: plural %q of %s is used by %s", hierarchy.Country, level.Plural, level.Label, other)
+			}
+
+			if len(level.Parents) < 1 {
+				return nil, fmt.Errorf("%s: level %s must have parents, %s is the parent of the top level", hierarchy.Country, level.Label, CountryNode)
+			}
+
+			for _, parent := range level.Parents {
+				if !defined[parent] {
+					return nil, fmt.Errorf("%s: parent %s of %s must be defined before the level", hierarchy.Country, parent, level.Label)
+				}
+			}
+
+			defined[level.Label] = true
+			plurals[level.Plural] = level.Label
+
+			existing, ok := merged[level.Label]
+			if !ok {
+				existing = &Level{
+					Label:       level.Label,
+					Plural:      level.Plural,
+					Description: level.Description,
+				}
+				if len(existing.Description) < 1 {
+					existing.Description = fmt.Sprintf("%s administrative division", level.Label)
+				}
+
+				merged[level.Label] = existing
+				levels = append(levels, existing)
+			}
+
+			if existing.Plural != level.Plural {
+				return nil, fmt.Errorf("%s: plural %q of %s is defined as %q", hierarchy.Country, level.Plural, level.Label, existing.Plural)
+			}
+
+			for _, parent := range level.Parents {
+				if !contains(existing.Parents, parent) {
+					existing.Parents = append(existing.Parents, parent)
+				}
+			}
+		}
+	}
+
+	// depth is the longest path from the country, the merged parents of other countries can make a cycle
+	depths := map[string]int{CountryNode: 0}
+	var depth func(label string, visiting map[string]bool) (int, error)
+	depth = func(label string, visiting map[string]bool) (int, error) {
+		if d, ok := depths[label]; ok {
+			return d, nil
+		}
+		if visiting[label] {
+			return 0, fmt.Errorf("level %s is an ancestor of itself", label)
+		}
+		visiting[label] = true
+
+		d := 1
+		for _, parent := range merged[label].Parents {
+			p, err := depth(parent, visiting)
+			if err != nil {
+				return 0, err
+			}
+			if p+1 > d {
+				d = p + 1
+			}
+		}
+
+		depths[label] = d
+		return d, nil
+	}
+
+	for _, level := range levels {
+		if _, err := depth(level.Label, map[string]bool{}); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(levels, func(i, j int) bool {
+		return depths[levels[i].Label] < depths[levels[j].Label]
+	})
+
+	return levels, nil
+}
+
+// LoadHierarchies reads the countries with the levels ordered from the upper level of the file, e.g. hierarchy.yaml
+func LoadHierarchies(file string) ([]*CountryHierarchy, error) {
+	v := viper.New()
+	v.SetConfigFile(file)
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	return unmarshalHierarchies(v, file)
+}
+
+// ReadHierarchies reads the countries with the levels ordered from the upper level of the YAML, e.g. DefaultHierarchy
+func ReadHierarchies(r io.Reader) ([]*CountryHierarchy, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+
+	if err := v.ReadConfig(r); err != nil {
+		return nil, err
+	}
+
+	return unmarshalHierarchies(v, "YAML")
+}
+
+// HierarchyError returns the error of the HIERARCHY_FILE file, the default levels are used when the file is invalid,
+// so the application checks it before starting
+func HierarchyError() error {
+	return hierarchyErr
+}
+
+func unmarshalHierarchies(v *viper.Viper, source string) ([]*CountryHierarchy, error) {
+	var hierarchies []*CountryHierarchy
+	if err := v.UnmarshalKey("hierarchies", &hierarchies); err != nil {
+		return nil, err
+	}
+
+	if len(hierarchies) < 1 {
+		return nil, fmt.Errorf("%s has no hierarchy", source)
+	}
+
+	return hierarchies, nil
+}
+
+// loadLevels merges the levels of the HIERARCHY_FILE file or DefaultHierarchy when it's empty, the levels are read
+// before the schema is created, the file is only read when it's set so the packages don't depend on the working directory
+func loadLevels() ([]*CountryHierarchy, []*Level, error) {
+	if file := os.Getenv(envHierarchyFile); len(file) > 0 {
+		hierarchies, err := LoadHierarchies(file)
+		if err == nil {
+			var levels []*Level
+			if levels, err = MergeLevels(hierarchies); err == nil {
+				return hierarchies, levels, nil
+			}
+		}
+
+		defaults, levels := defaultLevels()
+		return defaults, levels, fmt.Errorf("%s: %v", file, err)
+	}
+
+	hierarchies, levels := defaultLevels()
+	return hierarchies, levels, nil
+}
+
+// defaultLevels merges the levels of DefaultHierarchy, the default is checked by the tests
+func defaultLevels() ([]*CountryHierarchy, []*Level) {
+	hierarchies, _ := ReadHierarchies(strings.NewReader(DefaultHierarchy))
+	levels, _ := MergeLevels(hierarchies)
+
+	return hierarchies, levels
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
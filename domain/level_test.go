@@ -0,0 +1,208 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/domain"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type LevelSuite struct {
+	suite.Suite
+}
+
+func Test_LevelSuite(t *testing.T) {
+	suite.Run(t, new(LevelSuite))
+}
+
+func (l *LevelSuite) Test_Levels_Indonesia() {
+	assert.Equal(l.T(), []string{"Province", "City", "Regency", "District", "Village"}, domain.RegionNodes)
+	assert.Equal(l.T(), domain.DistrictNode, domain.Incoming["district"])
+	assert.Equal(l.T(), domain.CountryNode, domain.Incoming["currency"])
+	assert.Equal(l.T(), domain.RegencyNode, domain.ChildNodes["regencies"])
+	assert.Equal(l.T(), "DISTRICTS", domain.LevelOf(domain.DistrictNode).Relationship())
+	assert.Nil(l.T(), domain.LevelOf(domain.CountryNode))
+	assert.Len(l.T(), domain.UpperLevels(), 4)
+	assert.Len(l.T(), domain.TopLevels(), 1)
+	assert.Len(l.T(), domain.ChildLevels(domain.ProvinceNode), 2)
+	assert.Empty(l.T(), domain.ChildLevels(domain.VillageNode))
+}
+
+func (l *LevelSuite) Test_AncestorLevels() {
+	var labels []string
+	for _, level := range domain.AncestorLevels(domain.DistrictNode) {
+		labels = append(labels, level.Label)
+	}
+
+	assert.Equal(l.T(), []string{"Province", "City", "Regency"}, labels)
+	assert.Empty(l.T(), domain.AncestorLevels(domain.ProvinceNode))
+	assert.NotContains(l.T(), domain.LevelListArgs[domain.ProvinceNode], "district")
+	assert.NotContains(l.T(), domain.LevelListArgs[domain.RegencyNode], "city")
+	assert.Contains(l.T(), domain.LevelListArgs[domain.VillageNode], "city")
+	assert.NotContains(l.T(), domain.LevelListArgs[domain.RegencyNode]["where"].Type.(*graphql.InputObject).Fields(), "city")
+}
+
+func (l *LevelSuite) Test_MergeLevels_SharedLevel() {
+	levels, err := domain.MergeLevels([]*domain.CountryHierarchy{
+		{
+			Country: "ID",
+			Levels: []*domain.Level{
+				{Label: "Province", Plural: "provinces", Parents: []string{"Country"}},
+				{Label: "Regency", Plural: "regencies", Parents: []string{"Province"}},
+				{Label: "District", Plural: "districts", Parents: []string{"Regency"}},
+			},
+		},
+		{
+			Country: "MY",
+			Levels: []*domain.Level{
+				{Label: "State", Plural: "states", Description: "State or federal territory of Malaysia", Parents: []string{"Country"}},
+				{Label: "District", Plural: "districts", Parents: []string{"State"}},
+				{Label: "Mukim", Plural: "mukims", Parents: []string{"District"}},
+			},
+		},
+	})
+
+	assert.NoError(l.T(), err)
+
+	var labels []string
+	for _, level := range levels {
+		labels = append(labels, level.Label)
+	}
+	assert.Equal(l.T(), []string{"Province", "State", "Regency", "District", "Mukim"}, labels)
+	assert.Equal(l.T(), []string{"Regency", "State"}, levels[3].Parents)
+	assert.Equal(l.T(), []string{"Country"}, levels[1].Parents)
+	assert.Equal(l.T(), "State or federal territory of Malaysia", levels[1].Description)
+	assert.Equal(l.T(), "Mukim administrative division", levels[4].Description)
+}
+
+func (l *LevelSuite) Test_MergeLevels_CountryParent() {
+	levels, err := domain.MergeLevels([]*domain.CountryHierarchy{
+		{
+			Country: "ID",
+			Levels: []*domain.Level{
+				{Label: "Province", Plural: "provinces", Parents: []string{"Country"}},
+				{Label: "Regency", Plural: "regencies", Parents: []string{"Province"}},
+			},
+		},
+		{
+			Country: "PH",
+			Levels: []*domain.Level{
+				{Label: "Area", Plural: "areas", Parents: []string{"Country"}},
+				{Label: "Province", Plural: "provinces", Parents: []string{"Area"}},
+			},
+		},
+	})
+
+	assert.NoError(l.T(), err)
+	assert.Equal(l.T(), "Area", levels[0].Label)
+	assert.Equal(l.T(), "Province", levels[1].Label)
+	assert.Equal(l.T(), []string{"Country", "Area"}, levels[1].Parents)
+}
+
+func (l *LevelSuite) Test_MergeLevels_EmptyParents() {
+	levels, err := domain.MergeLevels([]*domain.CountryHierarchy{
+		{Country: "ID", Levels: []*domain.Level{{Label: "Province", Plural: "provinces"}}},
+	})
+
+	assert.Nil(l.T(), levels)
+	assert.EqualError(l.T(), err, "ID: level Province must have parents, Country is the parent of the top level")
+}
+
+func (l *LevelSuite) Test_DefaultHierarchy() {
+	defaults, err := domain.ReadHierarchies(strings.NewReader(domain.DefaultHierarchy))
+	assert.NoError(l.T(), err)
+
+	// hierarchy.yaml is the example of HIERARCHY_FILE with the default levels
+	file, err := domain.LoadHierarchies("../hierarchy.yaml")
+	assert.NoError(l.T(), err)
+
+	assert.Equal(l.T(), file, defaults)
+	assert.Equal(l.T(), defaults, domain.Hierarchies)
+	assert.NoError(l.T(), domain.HierarchyError())
+}
+
+func (l *LevelSuite) Test_ReadHierarchies_Empty() {
+	hierarchies, err := domain.ReadHierarchies(strings.NewReader("hierarchies: []"))
+
+	assert.Nil(l.T(), hierarchies)
+	assert.EqualError(l.T(), err, "YAML has no hierarchy")
+}
+
+func (l *LevelSuite) Test_LoadHierarchies_NotFound() {
+	hierarchies, err := domain.LoadHierarchies("not-found.yaml")
+
+	assert.Nil(l.T(), hierarchies)
+	assert.Error(l.T(), err)
+}
+
+func (l *LevelSuite) Test_MergeLevels_UndefinedParent() {
+	levels, err := domain.MergeLevels([]*domain.CountryHierarchy{
+		{
+			Country: "MY",
+			Levels: []*domain.Level{
+				{Label: "District", Plural: "districts", Parents: []string{"State"}},
+				{Label: "State", Plural: "states"},
+			},
+		},
+	})
+
+	assert.Nil(l.T(), levels)
+	assert.EqualError(l.T(), err, "MY: parent State of District must be defined before the level")
+}
+
+func (l *LevelSuite) Test_MergeLevels_PluralConflict() {
+	levels, err := domain.MergeLevels([]*domain.CountryHierarchy{
+		{Country: "ID", Levels: []*domain.Level{{Label: "District", Plural: "districts", Parents: []string{"Country"}}}},
+		{Country: "MY", Levels: []*domain.Level{{Label: "Daerah", Plural: "districts", Parents: []string{"Country"}}}},
+	})
+
+	assert.Nil(l.T(), levels)
+	assert.Error(l.T(), err)
+}
+
+func (l *LevelSuite) Test_MergeLevels_InvalidLabel() {
+	levels, err := domain.MergeLevels([]*domain.CountryHierarchy{
+		{Country: "ID", Levels: []*domain.Level{{Label: "province", Plural: "provinces", Parents: []string{"Country"}}}},
+	})
+
+	assert.Nil(l.T(), levels)
+	assert.Error(l.T(), err)
+}
+
+func (l *LevelSuite) Test_MergeLevels_Cycle() {
+	levels, err := domain.MergeLevels([]*domain.CountryHierarchy{
+		{
+			Country: "AA",
+			Levels: []*domain.Level{
+				{Label: "State", Plural: "states"},
+				{Label: "County", Plural: "counties", Parents: []string{"State"}},
+			},
+		},
+		{
+			Country: "BB",
+			Levels: []*domain.Level{
+				{Label: "County", Plural: "counties"},
+				{Label: "State", Plural: "states", Parents: []string{"County"}},
+			},
+		},
+	})
+
+	assert.Nil(l.T(), levels)
+	assert.Error(l.T(), err)
+}
+
+func (l *LevelSuite) Test_UnmarshalRegion_Children() {
+	var region domain.Region
+	err := json.Unmarshal([]byte(`{"id":"1","name":"Jawa Barat","regencies":[{"name":"Bandung","districts":[]}]}`), &region)
+
+	assert.NoError(l.T(), err)
+	assert.Equal(l.T(), "Jawa Barat", region.Name)
+	assert.Equal(l.T(), "Bandung", region.Children(domain.RegencyNode)[0].Name)
+	assert.NotNil(l.T(), region.Children(domain.RegencyNode)[0].Children(domain.DistrictNode))
+	assert.Nil(l.T(), region.Children(domain.CityNode))
+}
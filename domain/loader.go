@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+)
+
+type contextKey string
+
+const (
+	regionLoaderKey  contextKey = "region_loader"
+	countryLoaderKey contextKey = "country_loader"
+)
+
+// RegionLoader loads related administrative divisions of the request in batch
+type RegionLoader interface {
+	// Children loads the child nodes of the parent node with the id
+	Children(ctx context.Context, parent, id, child string) func() ([]*Region, error)
+	// Ancestors loads the country and upper administrative divisions of the node with the id
+	Ancestors(ctx context.Context, node, id string) func() (*Ancestors, error)
+	// Boundary loads the boundary geometry of the node with the id, nil when the node has no boundary
+	Boundary(ctx context.Context, node, id string) func() (*Boundary, error)
+	// Neighbours loads the nodes of the same level sharing a border with the node with the id
+	Neighbours(ctx context.Context, node, id string) func() ([]*Region, error)
+}
+
+// CountryLoader loads related nodes of countries of the request in batch
+type CountryLoader interface {
+	// Currencies loads currencies of the country with the id
+	Currencies(ctx context.Context, id string) func() ([]*Currency, error)
+	// Neighbours loads the countries sharing a border with the country with the id
+	Neighbours(ctx context.Context, id string) func() ([]*Country, error)
+}
+
+func WithRegionLoader(ctx context.Context, loader RegionLoader) context.Context {
+	return context.WithValue(ctx, regionLoaderKey, loader)
+}
+
+// RegionLoaderFrom returns the request loader, nil when the request has no loader
+func RegionLoaderFrom(ctx context.Context) RegionLoader {
+	if ctx == nil {
+		return nil
+	}
+
+	loader, _ := ctx.Value(regionLoaderKey).(RegionLoader)
+	return loader
+}
+
+func WithCountryLoader(ctx context.Context, loader CountryLoader) context.Context {
+	return context.WithValue(ctx, countryLoaderKey, loader)
+}
+
+// CountryLoaderFrom returns the request loader, nil when the request has no loader
+func CountryLoaderFrom(ctx context.Context) CountryLoader {
+	if ctx == nil {
+		return nil
+	}
+
+	loader, _ := ctx.Value(countryLoaderKey).(CountryLoader)
+	return loader
+}
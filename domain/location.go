@@ -0,0 +1,7 @@
+package domain
+
+// Location is the WGS-84 coordinate of the centroid, stored as point in the node
+type Location struct {
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lng"`
+}
@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+)
+
+type (
+	// Name is the normalized administrative division name, level is the node label of the recognized prefix
+	Name struct {
+		Level string
+		Text  string
+	}
+
+	namePrefix struct {
+		words []string
+		level string
+	}
+)
+
+var (
+	// namePrefixes are the administrative prefixes and the abbreviations in normalized form, longer prefix first
+	namePrefixes = []namePrefix{
+		{words: []string{"daerah", "khusus", "ibukota"}, level: ProvinceNode},
+		{words: []string{"daerah", "istimewa"}, level: ProvinceNode},
+		{words: []string{"provinsi"}, level: ProvinceNode},
+		{words: []string{"propinsi"}, level: ProvinceNode},
+		{words: []string{"prov"}, level: ProvinceNode},
+		{words: []string{"dki"}, level: ProvinceNode},
+		{words: []string{"di"}, level: ProvinceNode},
+		{words: []string{"kabupaten"}, level: RegencyNode},
+		{words: []string{"kab"}, level: RegencyNode},
+		{words: []string{"kotamadya"}, level: CityNode},
+		{words: []string{"kodya"}, level: CityNode},
+		{words: []string{"kota"}, level: CityNode},
+		{words: []string{"kecamatan"}, level: DistrictNode},
+		{words: []string{"kec"}, level: DistrictNode},
+		{words: []string{"kelurahan"}, level: VillageNode},
+		{words: []string{"kel"}, level: VillageNode},
+		{words: []string{"desa"}, level: VillageNode},
+		{words: []string{"ds"}, level: VillageNode},
+		// Kota and Kabupaten Administrasi of Jakarta have no level of their own
+		{words: []string{"administrasi"}},
+		{words: []string{"adm"}},
+	}
+
+	// nameAliases replace the whole name
+	nameAliases = map[string]Name{
+		"diy": {Level: ProvinceNode, Text: "yogyakarta"},
+	}
+
+	// nameAbbreviations expand the abbreviated words of the name
+	nameAbbreviations = map[string]string{
+		"kep":  "kepulauan",
+		"kepl": "kepulauan",
+	}
+
+	// NameAlternatives are the other written forms of the normalized words, used to match the stored names
+	NameAlternatives = map[string][]string{
+		"kepulauan": {"kep"},
+	}
+
+	// nameSpellings map the spelling before 1972 to the current spelling, e.g. "Soerabaja" is "Surabaja"
+	nameSpellings = strings.NewReplacer("oe", "u", "dj", "j", "tj", "c")
+)
+
+// ParseName folds case and punctuation, expands abbreviations and strips the administrative prefixes of the name,
+// e.g. "Kab. Bandung" is Regency "bandung" and "Kep. Seribu" is "kepulauan seribu"
+func ParseName(name string) Name {
+	words := nameWords(name)
+
+	if alias, ok := nameAliases[strings.Join(words, " ")]; ok {
+		return alias
+	}
+
+	var level string
+	for stripped := true; stripped; {
+		stripped = false
+
+		for _, prefix := range namePrefixes {
+			// Prefix is kept when it's the whole name, e.g. district "Kota"
+			if len(words) <= len(prefix.words) || !hasPrefix(words, prefix.words) {
+				continue
+			}
+
+			words = words[len(prefix.words):]
+			if len(level) < 1 {
+				level = prefix.level
+			}
+			stripped = true
+			break
+		}
+	}
+
+	return Name{
+		Level: level,
+		Text:  strings.Join(expand(words), " "),
+	}
+}
+
+// FoldName folds case and punctuation and expands abbreviations of the whole name, the prefixes are kept since
+// they can be part of the name, e.g. district "Kota Baru"
+func FoldName(name string) string {
+	return strings.Join(expand(nameWords(name)), " ")
+}
+
+// Modern returns the name in the current spelling, it's only used when the name as written matches nothing
+// since the current names can contain the old letters too, e.g. "oe" of village "Oebobo"
+func (n Name) Modern() Name {
+	return Name{
+		Level: n.Level,
+		Text:  nameSpellings.Replace(n.Text),
+	}
+}
+
+// NormalizeName returns the normalized name without the prefix, names written differently of the same
+// administrative division are equal after normalized
+func NormalizeName(name string) string {
+	return ParseName(name).Text
+}
+
+func nameWords(name string) []string {
+	return acronyms(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}))
+}
+
+// expand replaces the abbreviated words, e.g. "kep" is "kepulauan"
+func expand(words []string) []string {
+	for i, word := range words {
+		if expanded, ok := nameAbbreviations[word]; ok {
+			words[i] = expanded
+		}
+	}
+	return words
+}
+
+func hasPrefix(words, prefix []string) bool {
+	for i, word := range prefix {
+		if words[i] != word {
+			return false
+		}
+	}
+	return true
+}
+
+// acronyms joins the letters of dotted acronym, e.g. "D.K.I." is "dki"
+func acronyms(words []string) []string {
+	joined := make([]string, 0, len(words))
+
+	var letters string
+	for _, word := range words {
+		if len([]rune(word)) == 1 {
+			letters += word
+			continue
+		}
+
+		if len(letters) > 0 {
+			joined = append(joined, letters)
+			letters = ""
+		}
+		joined = append(joined, word)
+	}
+
+	if len(letters) > 0 {
+		joined = append(joined, letters)
+	}
+
+	return joined
+}
@@ -0,0 +1,53 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/dynastymasra/cartographer/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type NameSuite struct {
+	suite.Suite
+}
+
+func Test_NameSuite(t *testing.T) {
+	suite.Run(t, new(NameSuite))
+}
+
+func (n *NameSuite) Test_ParseName_Prefix() {
+	assert.Equal(n.T(), domain.Name{Level: domain.RegencyNode, Text: "bandung"}, domain.ParseName("Kab. Bandung"))
+	assert.Equal(n.T(), domain.Name{Level: domain.RegencyNode, Text: "bandung"}, domain.ParseName("kabupaten  BANDUNG"))
+	assert.Equal(n.T(), domain.Name{Level: domain.CityNode, Text: "bandung"}, domain.ParseName("Kota Bandung"))
+	assert.Equal(n.T(), domain.Name{Level: domain.DistrictNode, Text: "coblong"}, domain.ParseName("Kec. Coblong"))
+	assert.Equal(n.T(), domain.Name{Level: domain.VillageNode, Text: "dago"}, domain.ParseName("Kel. Dago"))
+	assert.Equal(n.T(), domain.Name{Level: domain.ProvinceNode, Text: "jakarta"}, domain.ParseName("DKI Jakarta"))
+	assert.Equal(n.T(), domain.Name{Level: domain.ProvinceNode, Text: "yogyakarta"}, domain.ParseName("Daerah Istimewa Yogyakarta"))
+	assert.Equal(n.T(), domain.Name{Level: domain.ProvinceNode, Text: "yogyakarta"}, domain.ParseName("D.I.Y."))
+	assert.Equal(n.T(), domain.Name{Level: domain.ProvinceNode, Text: "jakarta"}, domain.ParseName("D.K.I. Jakarta"))
+}
+
+func (n *NameSuite) Test_ParseName_Administrative() {
+	assert.Equal(n.T(), domain.Name{Level: domain.RegencyNode, Text: "kepulauan seribu"}, domain.ParseName("Kab. Adm. Kepulauan Seribu"))
+	assert.Equal(n.T(), domain.Name{Text: "kepulauan seribu"}, domain.ParseName("Adm. Kep. Seribu"))
+}
+
+func (n *NameSuite) Test_ParseName_Spelling() {
+	assert.Equal(n.T(), "oebobo", domain.NormalizeName("Oebobo"))
+	assert.Equal(n.T(), "surabaya", domain.ParseName("Soerabaya").Modern().Text)
+	assert.Equal(n.T(), domain.Name{Level: domain.ProvinceNode, Text: "jakarta"}, domain.ParseName("DKI Djakarta").Modern())
+	assert.Equal(n.T(), "cirebon", domain.ParseName("Tjirebon").Modern().Text)
+}
+
+func (n *NameSuite) Test_FoldName() {
+	assert.Equal(n.T(), "kota baru", domain.FoldName("Kota  Baru"))
+	assert.Equal(n.T(), "kabupaten kepulauan seribu", domain.FoldName("Kabupaten Kep. Seribu"))
+	assert.Equal(n.T(), "dki jakarta", domain.FoldName("D.K.I. Jakarta"))
+}
+
+func (n *NameSuite) Test_ParseName_OnlyPrefix() {
+	assert.Equal(n.T(), domain.Name{Text: "kota"}, domain.ParseName("Kota"))
+	assert.Equal(n.T(), domain.Name{}, domain.ParseName(" - "))
+}
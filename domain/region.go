@@ -1,48 +1,278 @@
 package domain
 
 import (
+	"encoding/json"
 	"time"
+
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
 )
 
+// Labels of the Indonesian levels, the levels of every country are defined in hierarchy.yaml
 const (
 	ProvinceNode = "Province"
 	CityNode     = "City"
 	RegencyNode  = "Regency"
 	DistrictNode = "District"
 	VillageNode  = "Village"
+
+	// BordersRelationship links the nodes of the same level sharing a border, stored in one direction and matched
+	// without direction, it isn't part of the hierarchy
+	BordersRelationship = "BORDERS"
+
+	// RegionFullTextIndex is the full-text index of the administrative division names created by the migration
+	RegionFullTextIndex = "region_name_fulltext"
 )
 
 var (
-	Incoming = map[string]string{
-		"country":  CountryNode,
-		"province": ProvinceNode,
-		"city":     CityNode,
-		"regency":  RegencyNode,
-		"district": DistrictNode,
-		"village":  VillageNode,
-		"currency": CountryNode,
-	}
+	// Incoming maps the arguments of the upper nodes to the node label, every level is mapped by the level field
+	Incoming = incoming()
 
 	Outgoing = map[string]string{
 		"currencies": CurrencyNode,
 	}
+
+	// Nested maps the input of the node related to the upper node to the node label, e.g. currency of the country
+	Nested = map[string]string{
+		"currency": CurrencyNode,
+	}
+
+	// RegionProperties are the stored properties of the administrative division nodes
+	RegionProperties = map[string]bool{
+		"id":        true,
+		"name":      true,
+		"code":      true,
+		"createdAt": true,
+		"updatedAt": true,
+		"location":  true,
+	}
+
+	// Hierarchy is the typed edges and the node properties created by the migrations, the relationship type is the child field in uppercase
+	Hierarchy = provider.NewHierarchy()
+
+	// RegionNodes are the node labels of every administrative division level, ordered from the upper level
+	RegionNodes = regionNodes()
+
+	// ChildNodes maps the child fields to the node label
+	ChildNodes = childNodes()
 )
 
+func init() {
+	for _, node := range RegionNodes {
+		for property := range RegionProperties {
+			Hierarchy.Node(node, property)
+		}
+	}
+	Hierarchy.Node(CountryNode, CountryProperties...)
+	Hierarchy.Node(CurrencyNode, CurrencyProperties...)
+
+	Hierarchy.Edge(CountryNode, "CURRENCIES", CurrencyNode)
+	for _, level := range Levels {
+		for _, parent := range level.Parents {
+			Hierarchy.Edge(parent, level.Relationship(), level.Label)
+		}
+	}
+
+	provider.UseHierarchy(Hierarchy)
+}
+
+func incoming() map[string]string {
+	nodes := map[string]string{
+		"country":  CountryNode,
+		"currency": CountryNode,
+	}
+	for _, level := range Levels {
+		nodes[level.Field()] = level.Label
+	}
+
+	return nodes
+}
+
+func regionNodes() []string {
+	nodes := make([]string, 0, len(Levels))
+	for _, level := range Levels {
+		nodes = append(nodes, level.Label)
+	}
+
+	return nodes
+}
+
+func childNodes() map[string]string {
+	nodes := make(map[string]string, len(Levels))
+	for _, level := range Levels {
+		nodes[level.Plural] = level.Label
+	}
+
+	return nodes
+}
+
 type (
 	Region struct {
 		ID   string `json:"id"`
 		Name string `json:"name"`
 		Code string `json:"code"`
-		Regions
-		CreatedAt time.Time `json:"createdAt"`
-		UpdatedAt time.Time `json:"updatedAt"`
+		// Location is the centroid, empty when the coordinate isn't imported
+		Location *Location `json:"location,omitempty"`
+		// Distance is the great-circle distance in meters to the point of the nearby query
+		Distance *float64 `json:"distance,omitempty"`
+		// Neighbours are the regions of the same level sharing a border, only filled by the neighbour lookup
+		Neighbours []*Region `json:"neighbours,omitempty"`
+		// Level is node label of the region, only filled for ancestors
+		Level   string `json:"level,omitempty"`
+		Regions `json:"-"`
+		// Ancestors is only filled when the ancestor fields are requested
+		Ancestors *Ancestors `json:"-"`
+		CreatedAt time.Time  `json:"createdAt"`
+		UpdatedAt time.Time  `json:"updatedAt"`
 	}
 
-	Regions struct {
-		Provinces []*Region `json:"provinces,omitempty"`
-		Cities    []*Region `json:"cities,omitempty"`
-		Regencies []*Region `json:"regencies,omitempty"`
-		Districts []*Region `json:"districts,omitempty"`
-		Villages  []*Region `json:"villages,omitempty"`
+	// RegionCount is total of nodes belong to the group region
+	RegionCount struct {
+		Group *Region `json:"group"`
+		Total int     `json:"total"`
 	}
+
+	// Ancestors is the country and upper administrative divisions of the region, path is ordered from the province
+	Ancestors struct {
+		ID      string    `json:"id"`
+		Country *Country  `json:"country"`
+		Path    []*Region `json:"path"`
+	}
+
+	// Search is the full text search of the administrative divisions, empty levels search every level
+	Search struct {
+		Text    string
+		Levels  []string
+		Country *provider.Query
+		Limit   int
+	}
+
+	// Regions are the child nodes by the node label, read from the list field of the level, e.g. regencies
+	Regions map[string][]*Region
 )
+
+// UnmarshalJSON reads the region and the child nodes under the list fields of the levels
+func (r *Region) UnmarshalJSON(data []byte) error {
+	type region Region
+	if err := json.Unmarshal(data, (*region)(r)); err != nil {
+		return err
+	}
+
+	regions, err := unmarshalRegions(data)
+	if err != nil {
+		return err
+	}
+	r.Regions = regions
+
+	return nil
+}
+
+// unmarshalRegions reads the child nodes from the list fields of the levels, nil when there's no list field
+func unmarshalRegions(data []byte) (Regions, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	var regions Regions
+	for _, level := range Levels {
+		value, ok := fields[level.Plural]
+		if !ok {
+			continue
+		}
+
+		var children []*Region
+		if err := json.Unmarshal(value, &children); err != nil {
+			return nil, err
+		}
+
+		if regions == nil {
+			regions = Regions{}
+		}
+		regions[level.Label] = children
+	}
+
+	return regions, nil
+}
+
+// Ancestor returns upper administrative division of the region with the node label
+func (r *Region) Ancestor(node string) *Region {
+	if r.Ancestors == nil {
+		return nil
+	}
+
+	for _, region := range r.Ancestors.Path {
+		if region.Level == node {
+			return region
+		}
+	}
+
+	return nil
+}
+
+// Parent returns the closest upper administrative division, the country is parent of the top levels
+func (r *Region) Parent() interface{} {
+	if r.Ancestors == nil {
+		return nil
+	}
+
+	if len(r.Ancestors.Path) > 0 {
+		return r.Ancestors.Path[len(r.Ancestors.Path)-1]
+	}
+
+	if r.Ancestors.Country != nil {
+		return r.Ancestors.Country
+	}
+
+	return nil
+}
+
+// Breadcrumb returns the country followed by the upper administrative divisions
+func (r *Region) Breadcrumb() []interface{} {
+	if r.Ancestors == nil {
+		return nil
+	}
+
+	path := make([]interface{}, 0, len(r.Ancestors.Path)+1)
+	if r.Ancestors.Country != nil {
+		path = append(path, r.Ancestors.Country)
+	}
+
+	for _, region := range r.Ancestors.Path {
+		path = append(path, region)
+	}
+
+	return path
+}
+
+// Descend fills ancestors of the children from ancestors of the region, level is node label of the region
+func (r *Region) Descend(level string, children []*Region) {
+	if r.Ancestors == nil {
+		return
+	}
+
+	parent := &Region{
+		ID:        r.ID,
+		Name:      r.Name,
+		Code:      r.Code,
+		Location:  r.Location,
+		Level:     level,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+
+	for _, child := range children {
+		path := make([]*Region, 0, len(r.Ancestors.Path)+1)
+		path = append(path, r.Ancestors.Path...)
+
+		child.Ancestors = &Ancestors{
+			ID:      child.ID,
+			Country: r.Ancestors.Country,
+			Path:    append(path, parent),
+		}
+	}
+}
+
+// Children returns the child nodes of the node label
+func (r Regions) Children(node string) []*Region {
+	return r[node]
+}
@@ -0,0 +1,28 @@
+package domain
+
+type (
+	// RegionValidation is the result of checking the supplied codes exist and belong to each other
+	RegionValidation struct {
+		Valid   bool           `json:"valid"`
+		Regions []*RegionCheck `json:"regions"`
+		Links   []*RegionLink  `json:"links"`
+	}
+
+	// RegionCheck is the supplied code of the level, exists is false when no node of the level has the code
+	RegionCheck struct {
+		Level  string `json:"level"`
+		Code   string `json:"code"`
+		Exists bool   `json:"exists"`
+	}
+
+	// RegionLink is the parent and child pair of the supplied codes,
+	// actual parent code is the code of the parent level the child belongs to in the graph
+	RegionLink struct {
+		Parent           string `json:"parent"`
+		ParentCode       string `json:"parentCode"`
+		Child            string `json:"child"`
+		ChildCode        string `json:"childCode"`
+		Valid            bool   `json:"valid"`
+		ActualParentCode string `json:"actualParentCode,omitempty"`
+	}
+)
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"sync"
+)
+
+// BatchFunc loads values of every key with one query, key without value is absent from the result
+type BatchFunc func(ctx context.Context, keys []string) (map[string]interface{}, error)
+
+// Batch collects keys until the first value is read, then every collected key is loaded with one call,
+// GraphQL executor reads thunks after the resolvers of the same level return so keys of a level are loaded together
+type Batch struct {
+	fetch   BatchFunc
+	mu      sync.Mutex
+	pending []string
+	queued  map[string]bool
+	loaded  map[string]bool
+	values  map[string]interface{}
+	errs    map[string]error
+}
+
+func NewBatch(fetch BatchFunc) *Batch {
+	return &Batch{
+		fetch:  fetch,
+		queued: map[string]bool{},
+		loaded: map[string]bool{},
+		values: map[string]interface{}{},
+		errs:   map[string]error{},
+	}
+}
+
+// Load queues the key and returns thunk to read the value, the key is loaded once per batch
+func (b *Batch) Load(ctx context.Context, key string) func() (interface{}, error) {
+	b.mu.Lock()
+	if !b.loaded[key] && !b.queued[key] {
+		b.queued[key] = true
+		b.pending = append(b.pending, key)
+	}
+	b.mu.Unlock()
+
+	return func() (interface{}, error) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if !b.loaded[key] {
+			b.dispatch(ctx)
+		}
+
+		return b.values[key], b.errs[key]
+	}
+}
+
+func (b *Batch) dispatch(ctx context.Context) {
+	keys := b.pending
+	b.pending = nil
+
+	values, err := b.fetch(ctx, keys)
+	for _, key := range keys {
+		delete(b.queued, key)
+		b.loaded[key] = true
+
+		if err != nil {
+			b.errs[key] = err
+			continue
+		}
+		b.values[key] = values[key]
+	}
+}
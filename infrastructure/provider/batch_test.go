@@ -0,0 +1,75 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type BatchSuite struct {
+	suite.Suite
+}
+
+func Test_BatchSuite(t *testing.T) {
+	suite.Run(t, new(BatchSuite))
+}
+
+func (b *BatchSuite) Test_Load_OneCall() {
+	var calls [][]string
+	batch := provider.NewBatch(func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+		calls = append(calls, keys)
+		return map[string]interface{}{"a": 1, "b": 2}, nil
+	})
+
+	loadA := batch.Load(context.Background(), "a")
+	loadB := batch.Load(context.Background(), "b")
+	loadC := batch.Load(context.Background(), "c")
+	loadAgain := batch.Load(context.Background(), "a")
+
+	valueA, errA := loadA()
+	valueB, errB := loadB()
+	valueC, errC := loadC()
+	valueAgain, _ := loadAgain()
+
+	assert.Equal(b.T(), [][]string{{"a", "b", "c"}}, calls)
+	assert.Equal(b.T(), 1, valueA)
+	assert.Equal(b.T(), 2, valueB)
+	assert.Nil(b.T(), valueC)
+	assert.Equal(b.T(), 1, valueAgain)
+	assert.NoError(b.T(), errA)
+	assert.NoError(b.T(), errB)
+	assert.NoError(b.T(), errC)
+}
+
+func (b *BatchSuite) Test_Load_NextTick() {
+	var calls [][]string
+	batch := provider.NewBatch(func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+		calls = append(calls, keys)
+		return map[string]interface{}{}, nil
+	})
+
+	_, _ = batch.Load(context.Background(), "a")()
+	_, _ = batch.Load(context.Background(), "b")()
+	_, _ = batch.Load(context.Background(), "a")()
+
+	assert.Equal(b.T(), [][]string{{"a"}, {"b"}}, calls)
+}
+
+func (b *BatchSuite) Test_Load_Error() {
+	batch := provider.NewBatch(func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+		return nil, assert.AnError
+	})
+
+	loadA := batch.Load(context.Background(), "a")
+	loadC := batch.Load(context.Background(), "c")
+
+	_, errA := loadA()
+	_, errC := loadC()
+
+	assert.Equal(b.T(), assert.AnError, errA)
+	assert.Equal(b.T(), assert.AnError, errC)
+}
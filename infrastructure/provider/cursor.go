@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// cursor is the position of the node, values are the node properties of the orderings in the same order
+type cursor struct {
+	Orderings []*Ordering   `json:"orderings"`
+	Values    []interface{} `json:"values"`
+}
+
+// EncodeCursor encodes the orderings and the ordering values of a node as an opaque cursor
+func EncodeCursor(orderings []*Ordering, values ...interface{}) (string, error) {
+	res, err := json.Marshal(cursor{Orderings: orderings, Values: values})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.URLEncoding.EncodeToString(res), nil
+}
+
+// DecodeCursor decodes the orderings and the ordering values from a cursor created by EncodeCursor
+func DecodeCursor(value string) ([]*Ordering, []interface{}, error) {
+	res, err := base64.URLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var c cursor
+	if err := json.Unmarshal(res, &c); err != nil {
+		return nil, nil, err
+	}
+
+	if len(c.Orderings) != len(c.Values) {
+		return nil, nil, fmt.Errorf("cursor has %d values of %d orderings", len(c.Values), len(c.Orderings))
+	}
+
+	return c.Orderings, c.Values, nil
+}
+
+// SameOrderings checks the orderings have the same fields and directions in the same order
+func SameOrderings(a, b []*Ordering) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Field != b[i].Field || a[i].Direction != b[i].Direction {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Seek adds filter to get only nodes positioned after the values in the query orderings,
+// values must be in the same order as the orderings, null is ordered after every value in ascending order
+// and before every value in descending order as ordered by Neo4j
+func (q *Query) Seek(values []interface{}) *Query {
+	var positions []*Filter
+
+	for i, order := range q.Orderings {
+		if i >= len(values) {
+			break
+		}
+
+		var members []*Filter
+		for j := 0; j < i; j++ {
+			if values[j] == nil {
+				members = append(members, NewFilter(q.Orderings[j].Field, IsNull, nil))
+				continue
+			}
+			members = append(members, NewFilter(q.Orderings[j].Field, Equal, values[j]))
+		}
+
+		after := seekAfter(order, values[i])
+		if after == nil {
+			continue
+		}
+
+		positions = append(positions, NewGroup(And, append(members, after)...))
+	}
+
+	if len(positions) < 1 && len(values) > 0 && len(q.Orderings) > 0 {
+		// nothing is positioned after the null of every ascending ordering
+		return q.Filter(q.Orderings[0].Field, In, []interface{}{})
+	}
+	if len(positions) < 1 {
+		return q
+	}
+
+	return q.Group(Or, positions...)
+}
+
+// seekAfter returns filter of the values positioned after the value in the ordering, nil when nothing is after
+func seekAfter(order *Ordering, value interface{}) *Filter {
+	if order.Direction == Descending {
+		if value == nil {
+			return NewFilter(order.Field, IsNotNull, nil)
+		}
+		return NewFilter(order.Field, LessThan, value)
+	}
+
+	if value == nil {
+		return nil
+	}
+	return NewGroup(Or, NewFilter(order.Field, GreaterThan, value), NewFilter(order.Field, IsNull, nil))
+}
+
+// Reverse flips the direction of all query orderings
+func (q *Query) Reverse() *Query {
+	orderings := make([]*Ordering, 0, len(q.Orderings))
+
+	for _, order := range q.Orderings {
+		direction := Descending
+		if order.Direction == Descending {
+			direction = Ascending
+		}
+		orderings = append(orderings, NewOrdering(order.Field, direction))
+	}
+	q.Orderings = orderings
+
+	return q
+}
+
+// Copy returns a copy of the query, modifying filters, orderings or relations of the copy doesn't change the query
+func (q *Query) Copy() *Query {
+	query := *q
+	query.Incomings = append([]*Query(nil), q.Incomings...)
+	query.Outgoings = append([]*Query(nil), q.Outgoings...)
+	query.Filters = append([]*Filter(nil), q.Filters...)
+	query.Orderings = append([]*Ordering(nil), q.Orderings...)
+	query.Fields = append([]string(nil), q.Fields...)
+	query.Expands = append([]*Expansion(nil), q.Expands...)
+
+	return &query
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// FullTextQuery translates the text to a fuzzy full-text index query, every word must match exactly, as prefix
+// or with edit distance, exact match is ranked higher. Alternatives are the other written forms of the word
+// matched exactly or as prefix. Symbols are dropped so the text can't change the query syntax,
+// empty string is returned when the text has no word.
+func FullTextQuery(text string, alternatives map[string][]string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		matches := []string{word + "^2", word + "*"}
+		// Fuzzy match of short word matches too many names
+		if len([]rune(word)) >= 4 {
+			matches = append(matches, word+"~")
+		}
+
+		for _, alternative := range alternatives[word] {
+			matches = append(matches, alternative+"^2", alternative+"*")
+		}
+
+		terms = append(terms, fmt.Sprintf("+(%s)", strings.Join(matches, " OR ")))
+	}
+
+	return strings.Join(terms, " ")
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// hierarchy types the relationship between the query nodes, relationship is untyped and unbounded when it's nil
+var hierarchy *Hierarchy
+
+type (
+	// Relationship is the edge types and the number of hops between two node labels
+	Relationship struct {
+		Types []string
+		Min   int
+		Max   int
+	}
+
+	// Hierarchy is the typed edges from the parent node label to the child node labels
+	Hierarchy struct {
+		edges      map[string][]edge
+		properties map[string]map[string]bool
+	}
+
+	edge struct {
+		child string
+		kind  string
+	}
+)
+
+// UseHierarchy sets the hierarchy used to type the relationship of the incoming, outgoing and expanded queries
+func UseHierarchy(h *Hierarchy) {
+	hierarchy = h
+}
+
+// RelationshipOf returns the relationship from the ancestor to the descendant of the used hierarchy,
+// nil when no hierarchy is used or the labels are not connected
+func RelationshipOf(ancestor, descendant string) *Relationship {
+	if hierarchy == nil {
+		return nil
+	}
+
+	return hierarchy.Relationship(ancestor, descendant)
+}
+
+func NewHierarchy() *Hierarchy {
+	return &Hierarchy{
+		edges:      map[string][]edge{},
+		properties: map[string]map[string]bool{},
+	}
+}
+
+// Edge adds the edge type from the parent to the children labels
+func (h *Hierarchy) Edge(parent, kind string, children ...string) *Hierarchy {
+	for _, child := range children {
+		h.edges[parent] = append(h.edges[parent], edge{child: child, kind: kind})
+	}
+	return h
+}
+
+// Node registers the stored properties of the node label, only these are written to the filter, ordering and projection
+func (h *Hierarchy) Node(label string, properties ...string) *Hierarchy {
+	if h.properties[label] == nil {
+		h.properties[label] = map[string]bool{}
+	}
+	for _, property := range properties {
+		h.properties[label][property] = true
+	}
+	return h
+}
+
+// Property checks the property is registered for the node label, any property is allowed when the label has none
+func (h *Hierarchy) Property(label, property string) bool {
+	properties, ok := h.properties[label]
+	if !ok {
+		return true
+	}
+	return properties[property]
+}
+
+// Relationship collects the edge types and the length of every path from the ancestor to the descendant,
+// e.g. province to village through city or regency is [:CITIES|DISTRICTS|REGENCIES|VILLAGES*3]
+func (h *Hierarchy) Relationship(ancestor, descendant string) *Relationship {
+	kinds := map[string]bool{}
+	min, max := 0, 0
+
+	var walk func(node string, depth int, visited map[string]bool, path []string)
+	walk = func(node string, depth int, visited map[string]bool, path []string) {
+		if node == descendant && depth > 0 {
+			for _, kind := range path {
+				kinds[kind] = true
+			}
+			if min == 0 || depth < min {
+				min = depth
+			}
+			if depth > max {
+				max = depth
+			}
+			return
+		}
+
+		for _, e := range h.edges[node] {
+			if visited[e.child] {
+				continue
+			}
+			visited[e.child] = true
+			walk(e.child, depth+1, visited, append(path, e.kind))
+			delete(visited, e.child)
+		}
+	}
+	walk(ancestor, 0, map[string]bool{ancestor: true}, nil)
+
+	if max == 0 {
+		return nil
+	}
+
+	types := make([]string, 0, len(kinds))
+	for kind := range kinds {
+		types = append(types, kind)
+	}
+	sort.Strings(types)
+
+	return &Relationship{
+		Types: types,
+		Min:   min,
+		Max:   max,
+	}
+}
+
+// Pattern returns the relationship pattern without direction, unknown relationship matches any type and length
+func (r *Relationship) Pattern() string {
+	if r == nil {
+		return "[*]"
+	}
+
+	var types string
+	if len(r.Types) > 0 {
+		types = ":" + strings.Join(r.Types, "|")
+	}
+
+	switch {
+	case r.Min == 1 && r.Max == 1:
+		return fmt.Sprintf("[%s]", types)
+	case r.Min == r.Max:
+		return fmt.Sprintf("[%s*%d]", types, r.Min)
+	default:
+		return fmt.Sprintf("[%s*%d..%d]", types, r.Min, r.Max)
+	}
+}
+
+// Step returns the outgoing pattern to the direct child, any edge type of one hop when the relationship is unknown
+func (r *Relationship) Step() string {
+	if r == nil {
+		return "-->"
+	}
+
+	return fmt.Sprintf("-%s->", r.Pattern())
+}
+
+// Merge combines the edge types and the number of hops of both relationships,
+// e.g. country to district and country to village is [:CITIES|DISTRICTS|PROVINCES|REGENCIES|VILLAGES*3..4]
+func (r *Relationship) Merge(relationship *Relationship) *Relationship {
+	if r == nil {
+		return relationship
+	}
+	if relationship == nil {
+		return r
+	}
+
+	merged := &Relationship{
+		Types: append([]string(nil), r.Types...),
+		Min:   r.Min,
+		Max:   r.Max,
+	}
+
+	for _, kind := range relationship.Types {
+		if !contains(merged.Types, kind) {
+			merged.Types = append(merged.Types, kind)
+		}
+	}
+	sort.Strings(merged.Types)
+
+	if relationship.Min < merged.Min {
+		merged.Min = relationship.Min
+	}
+	if relationship.Max > merged.Max {
+		merged.Max = relationship.Max
+	}
+
+	return merged
+}
@@ -0,0 +1,169 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type HierarchySuite struct {
+	suite.Suite
+	hierarchy *provider.Hierarchy
+}
+
+func Test_HierarchySuite(t *testing.T) {
+	suite.Run(t, new(HierarchySuite))
+}
+
+func (h *HierarchySuite) SetupTest() {
+	h.hierarchy = provider.NewHierarchy().
+		Edge("Country", "PROVINCES", "Province").
+		Edge("Country", "CURRENCIES", "Currency").
+		Edge("Province", "CITIES", "City").
+		Edge("Province", "REGENCIES", "Regency").
+		Edge("City", "DISTRICTS", "District").
+		Edge("Regency", "DISTRICTS", "District").
+		Edge("District", "VILLAGES", "Village")
+}
+
+func (h *HierarchySuite) TearDownTest() {
+	provider.UseHierarchy(nil)
+}
+
+func (h *HierarchySuite) Test_Relationship_Direct() {
+	relationship := h.hierarchy.Relationship("Province", "Regency")
+
+	assert.Equal(h.T(), &provider.Relationship{Types: []string{"REGENCIES"}, Min: 1, Max: 1}, relationship)
+	assert.Equal(h.T(), "[:REGENCIES]", relationship.Pattern())
+	assert.Equal(h.T(), "-[:REGENCIES]->", relationship.Step())
+}
+
+func (h *HierarchySuite) Test_Relationship_FixedLength() {
+	relationship := h.hierarchy.Relationship("Province", "Village")
+
+	assert.Equal(h.T(), "[:CITIES|DISTRICTS|REGENCIES|VILLAGES*3]", relationship.Pattern())
+}
+
+func (h *HierarchySuite) Test_Relationship_Bounds() {
+	hierarchy := provider.NewHierarchy().
+		Edge("Province", "CITIES", "City").
+		Edge("Province", "DISTRICTS", "District").
+		Edge("City", "DISTRICTS", "District")
+
+	assert.Equal(h.T(), "[:CITIES|DISTRICTS*1..2]", hierarchy.Relationship("Province", "District").Pattern())
+}
+
+func (h *HierarchySuite) Test_Relationship_NotConnected() {
+	relationship := h.hierarchy.Relationship("Village", "Province")
+
+	assert.Nil(h.T(), relationship)
+	assert.Equal(h.T(), "[*]", relationship.Pattern())
+	assert.Equal(h.T(), "-->", relationship.Step())
+}
+
+func (h *HierarchySuite) Test_TranslateQuery_Typed() {
+	provider.UseHierarchy(h.hierarchy)
+
+	query := provider.NewQuery("Village")
+	query.Incoming(provider.NewQuery("Province").Filter("code", provider.Equal, "32"))
+
+	match, _, _, _, err := provider.TranslateQuery(query)
+
+	district := provider.NewQuery("District")
+	district.ExpansionOf("villages", "Village")
+	projection, errProjection := provider.TranslateProjection(district)
+
+	assert.NoError(h.T(), err)
+	assert.NoError(h.T(), errProjection)
+	assert.Equal(h.T(), "(village:Village), (village)<-[:CITIES|DISTRICTS|REGENCIES|VILLAGES*3]-(province:Province)", match)
+	assert.Equal(h.T(), "district {.id, villages: [(district)-[:VILLAGES]->(village:Village) | village {.id}]}", projection)
+}
+
+func (h *HierarchySuite) Test_TranslateQuery_NotConnected() {
+	provider.UseHierarchy(h.hierarchy)
+
+	query := provider.NewQuery("Province")
+	query.Incoming(provider.NewQuery("District").Filter("code", provider.Equal, "3201010"))
+
+	_, _, _, _, err := provider.TranslateQuery(query)
+
+	assert.EqualError(h.T(), err, `unknown property "district" of Province`)
+}
+
+func (h *HierarchySuite) Test_TranslateQuery_NotConnectedOutgoing() {
+	provider.UseHierarchy(h.hierarchy)
+
+	query := provider.NewQuery("Regency")
+	query.Outgoing(provider.NewQuery("City"))
+
+	_, _, _, _, err := provider.TranslateQuery(query)
+
+	assert.IsType(h.T(), &provider.PropertyError{}, err)
+}
+
+func (h *HierarchySuite) Test_Property() {
+	h.hierarchy.Node("Province", "id", "name")
+
+	assert.True(h.T(), h.hierarchy.Property("Province", "name"))
+	assert.False(h.T(), h.hierarchy.Property("Province", "population"))
+	assert.True(h.T(), h.hierarchy.Property("Test", "population"))
+}
+
+func (h *HierarchySuite) Test_TranslateQuery_UnknownProperty() {
+	h.hierarchy.Node("Province", "id", "name")
+	provider.UseHierarchy(h.hierarchy)
+
+	query := provider.NewQuery("Province").Filter("population", provider.Equal, "1")
+
+	_, _, _, _, err := provider.TranslateQuery(query)
+
+	assert.EqualError(h.T(), err, `unknown property "population" of Province`)
+}
+
+func (h *HierarchySuite) Test_TranslateQuery_UnknownOrdering() {
+	h.hierarchy.Node("Province", "id", "name")
+	provider.UseHierarchy(h.hierarchy)
+
+	query := provider.NewQuery("Province").Ordering("name) DETACH DELETE province //", provider.Ascending)
+
+	_, _, _, _, err := provider.TranslateQuery(query)
+
+	assert.IsType(h.T(), &provider.PropertyError{}, err)
+}
+
+func (h *HierarchySuite) Test_TranslateQuery_UnknownIncomingProperty() {
+	h.hierarchy.Node("Province", "id", "name")
+	provider.UseHierarchy(h.hierarchy)
+
+	query := provider.NewQuery("Village")
+	query.Incoming(provider.NewQuery("Province").Filter("population", provider.Equal, "1"))
+
+	_, _, _, _, err := provider.TranslateQuery(query)
+
+	assert.EqualError(h.T(), err, `unknown property "population" of Province`)
+}
+
+func (h *HierarchySuite) Test_TranslateProjection_UnknownProperty() {
+	h.hierarchy.Node("Province", "id", "name")
+	provider.UseHierarchy(h.hierarchy)
+
+	query := provider.NewQuery("Province").Select("name", "population")
+
+	_, err := provider.TranslateProjection(query)
+
+	assert.EqualError(h.T(), err, `unknown property "population" of Province`)
+}
+
+func (h *HierarchySuite) Test_Relationship_Merge() {
+	district := h.hierarchy.Relationship("Country", "District")
+	village := h.hierarchy.Relationship("Country", "Village")
+
+	merged := district.Merge(village)
+
+	assert.Equal(h.T(), []string{"CITIES", "DISTRICTS", "PROVINCES", "REGENCIES", "VILLAGES"}, merged.Types)
+	assert.Equal(h.T(), "[:CITIES|DISTRICTS|PROVINCES|REGENCIES|VILLAGES*3..4]", merged.Pattern())
+	assert.Equal(h.T(), district, (*provider.Relationship)(nil).Merge(district))
+}
@@ -3,7 +3,10 @@ package provider
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/matryer/resync"
 	"github.com/neo4j/neo4j-go-driver/neo4j"
@@ -48,18 +51,47 @@ func (n Neo4J) Driver() (neo4j.Driver, error) {
 }
 
 const (
-	Equal = "Equal"
-	In    = "In"
+	Equal       = "Equal"
+	NotEqual    = "NotEqual"
+	In          = "In"
+	NotIn       = "NotIn"
+	Contains    = "Contains"
+	StartsWith  = "StartsWith"
+	EndsWith    = "EndsWith"
+	GreaterThan = "GreaterThan"
+	LessThan    = "LessThan"
+	Regex       = "Regex"
+	IsNull      = "IsNull"
+	IsNotNull   = "IsNotNull"
+
+	And = "And"
+	Or  = "Or"
+	Not = "Not"
 
 	Descending = "Descending"
 	Ascending  = "Ascending"
 )
 
 var (
+	// identifier is the node label and property name written to Cypher without escaping
+	identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 	validOrdering = map[string]bool{
 		Descending: true,
 		Ascending:  true,
 	}
+
+	// operators format the cypher comparison for a condition, property first and parameter second
+	operators = map[string]string{
+		Equal:       "%s = %s",
+		NotEqual:    "%s <> %s",
+		Contains:    "%s CONTAINS %s",
+		StartsWith:  "%s STARTS WITH %s",
+		EndsWith:    "%s ENDS WITH %s",
+		GreaterThan: "%s > %s",
+		LessThan:    "%s < %s",
+		Regex:       "%s =~ %s",
+	}
 )
 
 type (
@@ -71,20 +103,92 @@ type (
 		Outgoings []*Query
 		Filters   []*Filter
 		Orderings []*Ordering
+		// Fields are the returned properties, the whole tree is returned when nothing is projected
+		Fields  []string
+		Expands []*Expansion
+		// Relationship is the path from the upper node to the lower node when the query is related to other query
+		Relationship *Relationship
+		// Distance filters and orders the nodes by the distance to the point, the distance is returned by the projection
+		Distance *Distance
+	}
+
+	// Distance is the great-circle distance of the WGS-84 point property to the point,
+	// radius is the maximum distance in meters, zero means no maximum
+	Distance struct {
+		Field     string
+		Latitude  float64
+		Longitude float64
+		Radius    float64
+	}
+
+	// Expansion is the child nodes returned as list under the key of the parent
+	Expansion struct {
+		Key   string
+		Query *Query
 	}
 
 	Filter struct {
 		Condition string
 		Field     string
 		Value     interface{}
+		// Node is the label of related node the field belongs to, empty means the query node
+		Node string
+		// Filters is the members of And, Or and Not group
+		Filters []*Filter
 	}
 
 	Ordering struct {
 		Field     string
 		Direction string
 	}
+
+	// PropertyError is returned when the node label, the property or the condition of the query can't be written to Cypher
+	PropertyError struct {
+		Node      string
+		Field     string
+		Condition string
+
+		// condition is set when the condition is the invalid part, the condition can be empty
+		condition bool
+	}
 )
 
+func (e *PropertyError) Error() string {
+	switch {
+	case len(e.Condition) > 0 || e.condition:
+		return fmt.Sprintf("unknown condition %q of %s.%s", e.Condition, e.Node, e.Field)
+	case len(e.Field) > 0 || identifier.MatchString(e.Node):
+		return fmt.Sprintf("unknown property %q of %s", e.Field, e.Node)
+	}
+	return fmt.Sprintf("invalid node label %q", e.Node)
+}
+
+// validNode checks the label is an identifier
+func validNode(node string) error {
+	if !identifier.MatchString(node) {
+		return &PropertyError{Node: node}
+	}
+	return nil
+}
+
+// validProperty checks the property is registered for the label in the used hierarchy,
+// property of label without registered properties is only checked as identifier
+func validProperty(node, field string) error {
+	if !identifier.MatchString(field) || (hierarchy != nil && !hierarchy.Property(node, field)) {
+		return &PropertyError{Node: node, Field: field}
+	}
+	return nil
+}
+
+// validRelationship checks the related node label has a path to the node in the used hierarchy, the untyped
+// and unbounded relationship is only written when no hierarchy is used
+func validRelationship(node, related string, relationship *Relationship) error {
+	if hierarchy != nil && relationship == nil {
+		return &PropertyError{Node: node, Field: strings.ToLower(related)}
+	}
+	return nil
+}
+
 func NewQuery(node string) *Query {
 	return &Query{
 		Node: node,
@@ -98,12 +202,22 @@ func (q *Query) Ordering(property, direction string) *Query {
 	return q
 }
 
+// Incoming adds the upper node query, the relationship is typed from the used hierarchy
 func (q *Query) Incoming(query *Query) *Query {
+	if query.Relationship == nil {
+		query.Relationship = RelationshipOf(query.Node, q.Node)
+	}
+
 	q.Incomings = append(q.Incomings, query)
 	return q
 }
 
+// Outgoing adds the lower node query, the relationship is typed from the used hierarchy
 func (q *Query) Outgoing(query *Query) *Query {
+	if query.Relationship == nil {
+		query.Relationship = RelationshipOf(q.Node, query.Node)
+	}
+
 	q.Outgoings = append(q.Outgoings, query)
 	return q
 }
@@ -115,6 +229,174 @@ func (q *Query) Filter(property, condition string, value interface{}) *Query {
 	return q
 }
 
+// Group adds a group of filters combined with And, Or or Not
+func (q *Query) Group(condition string, filters ...*Filter) *Query {
+	q.Filters = append(q.Filters, NewGroup(condition, filters...))
+	return q
+}
+
+// Where adds filters from a map of property with map of condition and value,
+// condition key is the lower camel case of the condition e.g. {"name": {"startsWith": "Suka"}}.
+// Keys "and", "or" and "not" create filter group, keys found in incoming or outgoing
+// are filters of the related node and add the node to the query when it's not matched yet.
+func (q *Query) Where(where map[string]interface{}, incoming, outgoing map[string]string) *Query {
+	q.Filters = append(q.Filters, q.where("", where, incoming, outgoing)...)
+	return q
+}
+
+func (q *Query) where(node string, where map[string]interface{}, incoming, outgoing map[string]string) []*Filter {
+	var filters []*Filter
+
+	for _, key := range sortedKeys(where) {
+		switch val := where[key].(type) {
+		case []interface{}:
+			var members []*Filter
+			for _, v := range val {
+				if w, ok := v.(map[string]interface{}); ok {
+					members = append(members, NewGroup(And, q.where(node, w, incoming, outgoing)...))
+				}
+			}
+
+			switch key {
+			case "and":
+				filters = append(filters, NewGroup(And, members...))
+			case "or":
+				filters = append(filters, NewGroup(Or, members...))
+			}
+		case map[string]interface{}:
+			if key == "not" {
+				filters = append(filters, NewGroup(Not, q.where(node, val, incoming, outgoing)...))
+				continue
+			}
+
+			if label, ok := incoming[key]; ok {
+				if label != q.Node {
+					q.IncomingOf(label)
+				}
+				filters = append(filters, q.where(q.alias(label), val, incoming, outgoing)...)
+				continue
+			}
+
+			if label, ok := outgoing[key]; ok {
+				if label != q.Node {
+					q.OutgoingOf(label)
+				}
+				filters = append(filters, q.where(q.alias(label), val, incoming, outgoing)...)
+				continue
+			}
+
+			for _, k := range sortedKeys(val) {
+				// empty condition is kept and rejected when the query is translated
+				condition := k
+				if len(k) > 0 {
+					condition = strings.ToUpper(k[:1]) + k[1:]
+				}
+
+				switch values := val[k].(type) {
+				case []interface{}:
+					// empty list is kept as one filter, nothing is in the empty list and everything is not in it
+					if len(values) < 1 {
+						filter := NewFilter(key, condition, values)
+						filter.Node = node
+						filters = append(filters, filter)
+						continue
+					}
+
+					for _, value := range values {
+						filter := NewFilter(key, condition, value)
+						filter.Node = node
+						filters = append(filters, filter)
+					}
+				default:
+					filter := NewFilter(key, condition, values)
+					filter.Node = node
+					filters = append(filters, filter)
+				}
+			}
+		}
+	}
+
+	return filters
+}
+
+// IncomingOf returns incoming query of the node, new incoming query is added when the node is not matched yet
+func (q *Query) IncomingOf(node string) *Query {
+	for _, query := range q.Incomings {
+		if query.Node == node {
+			return query
+		}
+	}
+
+	query := NewQuery(node)
+	q.Incoming(query)
+
+	return query
+}
+
+// OutgoingOf returns outgoing query of the node, new outgoing query is added when the node is not matched yet
+func (q *Query) OutgoingOf(node string) *Query {
+	for _, query := range q.Outgoings {
+		if query.Node == node {
+			return query
+		}
+	}
+
+	query := NewQuery(node)
+	q.Outgoing(query)
+
+	return query
+}
+
+// alias returns the filter node of label, empty when the label is the query node itself
+func (q *Query) alias(node string) string {
+	if node == q.Node {
+		return ""
+	}
+	return node
+}
+
+// Select adds the properties returned of the node
+func (q *Query) Select(fields ...string) *Query {
+	for _, field := range fields {
+		if !contains(q.Fields, field) {
+			q.Fields = append(q.Fields, field)
+		}
+	}
+	return q
+}
+
+// ExpansionOf returns the child query returned under the key, new expansion is added when the key is not expanded yet
+func (q *Query) ExpansionOf(key, node string) *Query {
+	for _, expand := range q.Expands {
+		if expand.Key == key {
+			return expand.Query
+		}
+	}
+
+	query := NewQuery(node)
+	query.Relationship = RelationshipOf(q.Node, node)
+	q.Expands = append(q.Expands, &Expansion{Key: key, Query: query})
+
+	return query
+}
+
+// Near filters the nodes within the radius in meters of the point and orders them from the nearest,
+// the nodes without the point property are skipped
+func (q *Query) Near(field string, latitude, longitude, radius float64) *Query {
+	q.Distance = &Distance{
+		Field:     field,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Radius:    radius,
+	}
+	return q
+}
+
+// Projected checks whether the returned properties or child nodes are selected, the distance is only projected
+func (q *Query) Projected() bool {
+	return len(q.Fields) > 0 || len(q.Expands) > 0 || q.Distance != nil
+}
+
 func (q *Query) Slice(offset, limit int) *Query {
 	q.Offset = offset
 	q.Limit = limit
@@ -131,6 +413,14 @@ func NewFilter(field, condition string, value interface{}) *Filter {
 	}
 }
 
+// NewGroup creates a filter group, And and Or combine the members, Not negates all members
+func NewGroup(condition string, filters ...*Filter) *Filter {
+	return &Filter{
+		Condition: condition,
+		Filters:   filters,
+	}
+}
+
 func NewOrdering(field, direction string) *Ordering {
 	d := direction
 
@@ -144,37 +434,110 @@ func NewOrdering(field, direction string) *Ordering {
 	}
 }
 
-func TranslateQuery(query *Query) (string, string, string, map[string]interface{}) {
+// TranslateQuery translates the query to match pattern, where clause, order clause and parameters,
+// error is returned when a node label or a property is not valid, so no unknown name is written to Cypher
+func TranslateQuery(query *Query) (string, string, string, map[string]interface{}, error) {
 	node := strings.ToLower(query.Node)
 
 	var nodes, q []string
 	f := make(map[string]interface{})
 
+	if err := validNode(query.Node); err != nil {
+		return "", "", "", nil, err
+	}
+
 	nodes = append(nodes, fmt.Sprintf("(%s:%s)", node, query.Node))
-	q, f = TranslateFilter(query, q, f)
+	q, f, err := TranslateFilter(query, q, f)
+	if err != nil {
+		return "", "", "", nil, err
+	}
 
 	for _, val := range query.Incomings {
-		nodes = append(nodes, fmt.Sprintf("(%s)<-[*]-(%s:%s)", node, strings.ToLower(val.Node), val.Node))
-		q, f = TranslateFilter(val, q, f)
+		if err := validNode(val.Node); err != nil {
+			return "", "", "", nil, err
+		}
+
+		if err := validRelationship(query.Node, val.Node, val.Relationship); err != nil {
+			return "", "", "", nil, err
+		}
+
+		nodes = append(nodes, fmt.Sprintf("(%s)<-%s-(%s:%s)", node, val.Relationship.Pattern(), strings.ToLower(val.Node), val.Node))
+		if q, f, err = TranslateFilter(val, q, f); err != nil {
+			return "", "", "", nil, err
+		}
+
+		// outgoing node of the upper node, e.g. currency of the country of the province
+		for _, out := range val.Outgoings {
+			if err := validNode(out.Node); err != nil {
+				return "", "", "", nil, err
+			}
+
+			if err := validRelationship(val.Node, out.Node, out.Relationship); err != nil {
+				return "", "", "", nil, err
+			}
+
+			nodes = append(nodes, fmt.Sprintf("(%s)-%s->(%s:%s)", strings.ToLower(val.Node), out.Relationship.Pattern(), strings.ToLower(out.Node), out.Node))
+			if q, f, err = TranslateFilter(out, q, f); err != nil {
+				return "", "", "", nil, err
+			}
+		}
 	}
 
 	for _, val := range query.Outgoings {
-		nodes = append(nodes, fmt.Sprintf("(%s)-[*]->(%s:%s)", node, strings.ToLower(val.Node), val.Node))
-		q, f = TranslateFilter(val, q, f)
+		if err := validNode(val.Node); err != nil {
+			return "", "", "", nil, err
+		}
+
+		if err := validRelationship(query.Node, val.Node, val.Relationship); err != nil {
+			return "", "", "", nil, err
+		}
+
+		nodes = append(nodes, fmt.Sprintf("(%s)-%s->(%s:%s)", node, val.Relationship.Pattern(), strings.ToLower(val.Node), val.Node))
+		if q, f, err = TranslateFilter(val, q, f); err != nil {
+			return "", "", "", nil, err
+		}
+	}
+
+	var o, orders []string
+	if query.Distance != nil {
+		distance, err := translateDistance(query)
+		if err != nil {
+			return "", "", "", nil, err
+		}
+
+		f[node+".distance"] = map[string]interface{}{
+			"latitude":  query.Distance.Latitude,
+			"longitude": query.Distance.Longitude,
+		}
+
+		q = append(q, fmt.Sprintf("%s.%s IS NOT NULL", node, query.Distance.Field))
+		if query.Distance.Radius > 0 {
+			q = append(q, fmt.Sprintf("%s <= $`%s.radius`", distance, node))
+			f[node+".radius"] = query.Distance.Radius
+		}
+
+		orders = append(orders, distance+" ASC")
 	}
 
-	var o []string
 	for _, order := range query.Orderings {
+		if err := validProperty(query.Node, order.Field); err != nil {
+			return "", "", "", nil, err
+		}
+
 		switch order.Direction {
 		case Ascending:
-			o = append(o, fmt.Sprintf("ORDER BY %s.%s %s", node, order.Field, "ASC"))
+			orders = append(orders, fmt.Sprintf("%s.%s %s", node, order.Field, "ASC"))
 		case Descending:
-			o = append(o, fmt.Sprintf("ORDER BY %s.%s %s", node, order.Field, "DESC"))
+			orders = append(orders, fmt.Sprintf("%s.%s %s", node, order.Field, "DESC"))
 		default:
-			o = append(o, fmt.Sprintf("ORDER BY %s.%s %s", node, order.Field, "ASC"))
+			orders = append(orders, fmt.Sprintf("%s.%s %s", node, order.Field, "ASC"))
 		}
 	}
 
+	if len(orders) > 0 {
+		o = append(o, fmt.Sprintf("ORDER BY %s", strings.Join(orders, ", ")))
+	}
+
 	if query.Offset > 0 {
 		o = append(o, fmt.Sprintf("SKIP %d", query.Offset))
 	}
@@ -190,38 +553,233 @@ func TranslateQuery(query *Query) (string, string, string, map[string]interface{
 	match := strings.Join(nodes, ", ")
 	order := strings.Join(o, " ")
 
-	return match, where, order, f
+	return match, where, order, f, nil
+}
+
+// TranslateProjection translates the selected properties and child nodes to a map projection,
+// id and the ordering fields are always returned, used by the cursor and the loaders
+func TranslateProjection(query *Query) (string, error) {
+	node := strings.ToLower(query.Node)
+
+	if err := validNode(query.Node); err != nil {
+		return "", err
+	}
+
+	fields := []string{"id"}
+	for _, field := range query.Fields {
+		if !contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	for _, order := range query.Orderings {
+		if !contains(fields, order.Field) {
+			fields = append(fields, order.Field)
+		}
+	}
+
+	properties := make([]string, 0, len(fields)+len(query.Expands))
+	for _, field := range fields {
+		if err := validProperty(query.Node, field); err != nil {
+			return "", err
+		}
+		properties = append(properties, "."+field)
+	}
+
+	if query.Distance != nil {
+		distance, err := translateDistance(query)
+		if err != nil {
+			return "", err
+		}
+		properties = append(properties, "distance: "+distance)
+	}
+
+	for _, expand := range query.Expands {
+		if !identifier.MatchString(expand.Key) {
+			return "", &PropertyError{Node: query.Node, Field: expand.Key}
+		}
+
+		if err := validRelationship(query.Node, expand.Query.Node, expand.Query.Relationship); err != nil {
+			return "", err
+		}
+
+		projection, err := TranslateProjection(expand.Query)
+		if err != nil {
+			return "", err
+		}
+
+		child := strings.ToLower(expand.Query.Node)
+		properties = append(properties, fmt.Sprintf("%s: [(%s)%s(%s:%s) | %s]",
+			expand.Key, node, expand.Query.Relationship.Step(), child, expand.Query.Node, projection))
+	}
+
+	return fmt.Sprintf("%s {%s}", node, strings.Join(properties, ", ")), nil
 }
 
-func TranslateFilter(query *Query, q []string, f map[string]interface{}) ([]string, map[string]interface{}) {
+// translateDistance returns the distance function of the point property to the point parameter
+func translateDistance(query *Query) (string, error) {
 	node := strings.ToLower(query.Node)
 
-	for _, filter := range query.Filters {
-		field := fmt.Sprintf("%s.%s", node, filter.Field)
+	if err := validProperty(query.Node, query.Distance.Field); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("distance(%s.%s, point($`%s.distance`))", node, query.Distance.Field, node), nil
+}
+
+func TranslateFilter(query *Query, q []string, f map[string]interface{}) ([]string, map[string]interface{}, error) {
+	return translateFilters(query.Node, query.Filters, q, f)
+}
+
+// translateFilters translates sibling filters, In and NotIn with same field are merged as one list parameter
+func translateFilters(node string, filters []*Filter, q []string, f map[string]interface{}) ([]string, map[string]interface{}, error) {
+	lists := make(map[string]string)
+
+	for _, filter := range filters {
 		switch filter.Condition {
-		case Equal:
-			q = append(q, fmt.Sprintf("%s.%s = $`%s`", node, filter.Field, field))
-			f[field] = filter.Value
-		case In:
-			if val, ok := f[field]; ok {
-				if v, ok := val.([]interface{}); ok {
-					f[field] = append(v, filter.Value)
+		case And, Or, Not:
+			members, values, err := translateFilters(node, filter.Filters, nil, f)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			f = values
+			if len(members) < 1 {
+				continue
+			}
+
+			switch filter.Condition {
+			case Or:
+				q = append(q, fmt.Sprintf("(%s)", strings.Join(members, " OR ")))
+			case Not:
+				q = append(q, fmt.Sprintf("NOT (%s)", strings.Join(members, " AND ")))
+			default:
+				q = append(q, fmt.Sprintf("(%s)", strings.Join(members, " AND ")))
+			}
+			continue
+		}
+
+		label := node
+		if len(filter.Node) > 0 {
+			label = filter.Node
+		}
+
+		if err := validNode(label); err != nil {
+			return nil, nil, err
+		}
+		if err := validProperty(label, filter.Field); err != nil {
+			return nil, nil, err
+		}
+
+		field := fmt.Sprintf("%s.%s", strings.ToLower(label), filter.Field)
+		value := parameterValue(filter.Value)
+
+		switch filter.Condition {
+		case In, NotIn:
+			list := fmt.Sprintf("%s.%s", field, filter.Condition)
+
+			// value of In and NotIn is one member or the list of members
+			members := []interface{}{value}
+			if values, ok := filter.Value.([]interface{}); ok {
+				members = make([]interface{}, 0, len(values))
+				for _, v := range values {
+					members = append(members, parameterValue(v))
+				}
+			}
+
+			if key, ok := lists[list]; ok {
+				if v, ok := f[key].([]interface{}); ok {
+					f[key] = append(v, members...)
 				}
-			} else {
-				q = append(q, fmt.Sprintf("%s.%s IN $`%s`", node, filter.Field, field))
-				f[field] = []interface{}{filter.Value}
+				continue
+			}
+
+			key := field
+			format := "%s IN $`%s`"
+			if filter.Condition == NotIn {
+				key = list
+				format = "NOT %s IN $`%s`"
 			}
+			key = parameterKey(key, f)
+
+			lists[list] = key
+			q = append(q, fmt.Sprintf(format, field, key))
+			f[key] = members
+		case Regex:
+			key := parameterKey(fmt.Sprintf("%s.%s", field, filter.Condition), f)
+			q = append(q, fmt.Sprintf(operators[Regex], field, fmt.Sprintf("$`%s`", key)))
+			// Regular expression always match case insensitive
+			f[key] = fmt.Sprintf("(?i)%v", value)
+		case NotEqual, Contains, StartsWith, EndsWith, GreaterThan, LessThan:
+			key := parameterKey(fmt.Sprintf("%s.%s", field, filter.Condition), f)
+			q = append(q, fmt.Sprintf(operators[filter.Condition], field, fmt.Sprintf("$`%s`", key)))
+			f[key] = value
+		case IsNull:
+			q = append(q, fmt.Sprintf("%s IS NULL", field))
+		case IsNotNull:
+			q = append(q, fmt.Sprintf("%s IS NOT NULL", field))
+		case Equal:
+			key := parameterKey(field, f)
+			q = append(q, fmt.Sprintf(operators[Equal], field, fmt.Sprintf("$`%s`", key)))
+			f[key] = value
 		default:
-			q = append(q, fmt.Sprintf("%s.%s = $`%s`", node, filter.Field, field))
-			f[field] = filter.Value
+			return nil, nil, &PropertyError{Node: label, Field: filter.Field, Condition: filter.Condition, condition: true}
+		}
+	}
+
+	return q, f, nil
+}
+
+// parameterKey returns unused parameter name, suffix with number when the key already used by other filter
+func parameterKey(key string, f map[string]interface{}) string {
+	if _, ok := f[key]; !ok {
+		return key
+	}
+
+	for i := 1; ; i++ {
+		k := fmt.Sprintf("%s_%d", key, i)
+		if _, ok := f[k]; !ok {
+			return k
+		}
+	}
+}
+
+// parameterValue converts value to the same format as stored in node properties
+func parameterValue(value interface{}) interface{} {
+	switch val := value.(type) {
+	case time.Time:
+		return val.UTC().Format(time.RFC3339)
+	case *time.Time:
+		if val == nil {
+			return nil
 		}
+		return val.UTC().Format(time.RFC3339)
+	default:
+		return value
 	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
 
-	return q, f
+	return keys
 }
 
+// RecordUnmarshal parses the record value to the struct through JSON, spatial points are parsed as lat and lng
 func RecordUnmarshal(data interface{}, v interface{}) error {
-	res, err := json.Marshal(data)
+	res, err := json.Marshal(recordValue(data))
 	if err != nil {
 		return err
 	}
@@ -232,3 +790,28 @@ func RecordUnmarshal(data interface{}, v interface{}) error {
 
 	return nil
 }
+
+// recordValue replaces the spatial points of the record value, the point fields are unexported and can't be
+// written to JSON, x of the WGS-84 point is the longitude and y is the latitude
+func recordValue(data interface{}) interface{} {
+	switch value := data.(type) {
+	case *neo4j.Point:
+		return map[string]float64{"lat": value.Y(), "lng": value.X()}
+	case neo4j.Point:
+		return recordValue(&value)
+	case map[string]interface{}:
+		values := make(map[string]interface{}, len(value))
+		for key, v := range value {
+			values[key] = recordValue(v)
+		}
+		return values
+	case []interface{}:
+		values := make([]interface{}, len(value))
+		for i, v := range value {
+			values[i] = recordValue(v)
+		}
+		return values
+	}
+
+	return data
+}
@@ -0,0 +1,418 @@
+package provider_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+
+	"github.com/neo4j/neo4j-go-driver/neo4j"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type Neo4JSuite struct {
+	suite.Suite
+}
+
+func Test_Neo4JSuite(t *testing.T) {
+	suite.Run(t, new(Neo4JSuite))
+}
+
+func (n *Neo4JSuite) Test_TranslateQuery_Equal() {
+	query := provider.NewQuery("City")
+	query.Filter("code", provider.Equal, "32.01")
+	query.Incoming(provider.NewQuery("Province").Filter("code", provider.Equal, "32"))
+	query.Ordering("name", provider.Ascending)
+	query.Slice(10, 5)
+
+	match, where, order, value, _ := provider.TranslateQuery(query)
+
+	assert.Equal(n.T(), "(city:City), (city)<-[*]-(province:Province)", match)
+	assert.Equal(n.T(), "WHERE city.code = $`city.code` AND province.code = $`province.code`", where)
+	assert.Equal(n.T(), "ORDER BY city.name ASC SKIP 10 LIMIT 5", order)
+	assert.Equal(n.T(), map[string]interface{}{
+		"city.code":     "32.01",
+		"province.code": "32",
+	}, value)
+}
+
+func (n *Neo4JSuite) Test_TranslateQuery_IncomingOutgoing() {
+	country := provider.NewQuery("Country")
+	country.Outgoing(provider.NewQuery("Currency").Filter("ISO4217Alphabetic", provider.Equal, "IDR"))
+
+	query := provider.NewQuery("Province")
+	query.Incoming(country)
+
+	match, where, _, value, err := provider.TranslateQuery(query)
+
+	assert.NoError(n.T(), err)
+	assert.Equal(n.T(), "(province:Province), (province)<-[*]-(country:Country), (country)-[*]->(currency:Currency)", match)
+	assert.Equal(n.T(), "WHERE currency.ISO4217Alphabetic = $`currency.ISO4217Alphabetic`", where)
+	assert.Equal(n.T(), "IDR", value["currency.ISO4217Alphabetic"])
+}
+
+func (n *Neo4JSuite) Test_TranslateFilter_Conditions() {
+	timestamp := time.Date(2020, 4, 15, 10, 15, 32, 0, time.UTC)
+
+	query := provider.NewQuery("Village")
+	query.Filter("name", provider.NotEqual, "Suka")
+	query.Filter("name", provider.Contains, "maju")
+	query.Filter("name", provider.StartsWith, "Suka")
+	query.Filter("name", provider.EndsWith, "jaya")
+	query.Filter("name", provider.Regex, "^suka.*")
+	query.Filter("createdAt", provider.GreaterThan, timestamp)
+	query.Filter("createdAt", provider.LessThan, timestamp)
+	query.Filter("code", provider.In, "1")
+	query.Filter("code", provider.In, "2")
+	query.Filter("code", provider.NotIn, "3")
+
+	q, f, _ := provider.TranslateFilter(query, nil, map[string]interface{}{})
+
+	assert.Equal(n.T(), []string{
+		"village.name <> $`village.name.NotEqual`",
+		"village.name CONTAINS $`village.name.Contains`",
+		"village.name STARTS WITH $`village.name.StartsWith`",
+		"village.name ENDS WITH $`village.name.EndsWith`",
+		"village.name =~ $`village.name.Regex`",
+		"village.createdAt > $`village.createdAt.GreaterThan`",
+		"village.createdAt < $`village.createdAt.LessThan`",
+		"village.code IN $`village.code`",
+		"NOT village.code IN $`village.code.NotIn`",
+	}, q)
+	assert.Equal(n.T(), "(?i)^suka.*", f["village.name.Regex"])
+	assert.Equal(n.T(), "2020-04-15T10:15:32Z", f["village.createdAt.GreaterThan"])
+	assert.Equal(n.T(), []interface{}{"1", "2"}, f["village.code"])
+	assert.Equal(n.T(), []interface{}{"3"}, f["village.code.NotIn"])
+}
+
+func (n *Neo4JSuite) Test_TranslateFilter_DuplicateCondition() {
+	query := provider.NewQuery("Village")
+	query.Filter("name", provider.Contains, "suka")
+	query.Filter("name", provider.Contains, "maju")
+
+	q, f, _ := provider.TranslateFilter(query, nil, map[string]interface{}{})
+
+	assert.Equal(n.T(), []string{
+		"village.name CONTAINS $`village.name.Contains`",
+		"village.name CONTAINS $`village.name.Contains_1`",
+	}, q)
+	assert.Equal(n.T(), "suka", f["village.name.Contains"])
+	assert.Equal(n.T(), "maju", f["village.name.Contains_1"])
+}
+
+func (n *Neo4JSuite) Test_Where() {
+	query := provider.NewQuery("Village")
+	query.Where(map[string]interface{}{
+		"name": map[string]interface{}{
+			"startsWith": "Suka",
+			"notIn":      []interface{}{"Sukamaju", "Sukajaya"},
+		},
+		"code": map[string]interface{}{
+			"equal": "32.01.01.2001",
+		},
+	}, nil, nil)
+
+	expected := provider.NewQuery("Village")
+	expected.Filter("code", provider.Equal, "32.01.01.2001")
+	expected.Filter("name", provider.NotIn, "Sukamaju")
+	expected.Filter("name", provider.NotIn, "Sukajaya")
+	expected.Filter("name", provider.StartsWith, "Suka")
+
+	assert.Equal(n.T(), expected, query)
+}
+
+func (n *Neo4JSuite) Test_Where_Group() {
+	incoming := map[string]string{"province": "Province"}
+
+	query := provider.NewQuery("Regency")
+	query.Where(map[string]interface{}{
+		"or": []interface{}{
+			map[string]interface{}{
+				"province": map[string]interface{}{
+					"code": map[string]interface{}{"equal": "31"},
+				},
+			},
+			map[string]interface{}{
+				"province": map[string]interface{}{
+					"code": map[string]interface{}{"equal": "32"},
+				},
+			},
+		},
+		"not": map[string]interface{}{
+			"name": map[string]interface{}{"contains": "Kepulauan"},
+		},
+	}, incoming, nil)
+
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	assert.Equal(n.T(), "(regency:Regency), (regency)<-[*]-(province:Province)", match)
+	assert.Equal(n.T(), "WHERE NOT (regency.name CONTAINS $`regency.name.Contains`) AND "+
+		"((province.code = $`province.code`) OR (province.code = $`province.code_1`))", where)
+	assert.Equal(n.T(), map[string]interface{}{
+		"regency.name.Contains": "Kepulauan",
+		"province.code":         "31",
+		"province.code_1":       "32",
+	}, value)
+}
+
+func (n *Neo4JSuite) Test_Where_RelationExists() {
+	incoming := map[string]string{"province": "Province", "regency": "Regency"}
+
+	query := provider.NewQuery("Regency")
+	query.Incoming(provider.NewQuery("Province").Filter("code", provider.Equal, "32"))
+	query.Where(map[string]interface{}{
+		"province": map[string]interface{}{
+			"name": map[string]interface{}{"startsWith": "Jawa"},
+		},
+		"regency": map[string]interface{}{
+			"code": map[string]interface{}{"in": []interface{}{"32.01", "32.02"}},
+		},
+	}, incoming, nil)
+
+	assert.Len(n.T(), query.Incomings, 1)
+
+	_, where, _, _, _ := provider.TranslateQuery(query)
+
+	assert.Equal(n.T(), "WHERE province.name STARTS WITH $`province.name.StartsWith` AND "+
+		"regency.code IN $`regency.code` AND province.code = $`province.code`", where)
+}
+
+func (n *Neo4JSuite) Test_TranslateFilter_EmptyGroup() {
+	query := provider.NewQuery("Village")
+	query.Group(provider.Or)
+	query.Group(provider.Not, provider.NewFilter("name", provider.Equal, "Suka"))
+
+	q, _, _ := provider.TranslateFilter(query, nil, map[string]interface{}{})
+
+	assert.Equal(n.T(), []string{"NOT (village.name = $`village.name`)"}, q)
+}
+
+func (n *Neo4JSuite) Test_Where_EmptyIn() {
+	query := provider.NewQuery("Village")
+	query.Where(map[string]interface{}{
+		"name": map[string]interface{}{"in": []interface{}{}},
+		"code": map[string]interface{}{"notIn": []interface{}{}},
+	}, nil, nil)
+
+	_, where, _, value, err := provider.TranslateQuery(query)
+
+	assert.NoError(n.T(), err)
+	assert.Equal(n.T(), "WHERE NOT village.code IN $`village.code.NotIn` AND village.name IN $`village.name`", where)
+	assert.Equal(n.T(), []interface{}{}, value["village.name"])
+	assert.Equal(n.T(), []interface{}{}, value["village.code.NotIn"])
+}
+
+func (n *Neo4JSuite) Test_TranslateFilter_UnknownCondition() {
+	query := provider.NewQuery("Village")
+	query.Where(map[string]interface{}{
+		"name": map[string]interface{}{"like": "Suka"},
+	}, nil, nil)
+
+	_, _, _, _, err := provider.TranslateQuery(query)
+
+	assert.EqualError(n.T(), err, `unknown condition "Like" of Village.name`)
+	assert.IsType(n.T(), &provider.PropertyError{}, err)
+}
+
+func (n *Neo4JSuite) Test_TranslateFilter_EmptyCondition() {
+	query := provider.NewQuery("Village")
+	query.Where(map[string]interface{}{
+		"name": map[string]interface{}{"": "Suka"},
+	}, nil, nil)
+
+	_, _, _, _, err := provider.TranslateQuery(query)
+
+	assert.EqualError(n.T(), err, `unknown condition "" of Village.name`)
+	assert.IsType(n.T(), &provider.PropertyError{}, err)
+}
+
+func (n *Neo4JSuite) Test_TranslateFilter_EmptyProperty() {
+	query := provider.NewQuery("Village")
+	query.Where(map[string]interface{}{
+		"": map[string]interface{}{"equal": "Suka"},
+	}, nil, nil)
+
+	_, _, _, _, err := provider.TranslateQuery(query)
+
+	assert.EqualError(n.T(), err, `unknown property "" of Village`)
+	assert.IsType(n.T(), &provider.PropertyError{}, err)
+}
+
+func (n *Neo4JSuite) Test_Seek() {
+	query := provider.NewQuery("City")
+	query.Ordering("name", provider.Ascending)
+	query.Ordering("id", provider.Descending)
+
+	cursor, err := provider.EncodeCursor(query.Orderings, "Bandung", "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	assert.NoError(n.T(), err)
+
+	orderings, values, err := provider.DecodeCursor(cursor)
+	assert.NoError(n.T(), err)
+	assert.True(n.T(), provider.SameOrderings(query.Orderings, orderings))
+
+	query.Seek(values)
+
+	_, where, order, value, _ := provider.TranslateQuery(query)
+
+	assert.Equal(n.T(), "WHERE (((city.name > $`city.name.GreaterThan` OR city.name IS NULL)) OR "+
+		"(city.name = $`city.name` AND city.id < $`city.id.LessThan`))", where)
+	assert.Equal(n.T(), "ORDER BY city.name ASC, city.id DESC", order)
+	assert.Equal(n.T(), map[string]interface{}{
+		"city.name.GreaterThan": "Bandung",
+		"city.name":             "Bandung",
+		"city.id.LessThan":      "e81f509f-38ec-42e8-9a1c-8e527977e526",
+	}, value)
+}
+
+func (n *Neo4JSuite) Test_Seek_Null() {
+	query := provider.NewQuery("City")
+	query.Ordering("code", provider.Ascending)
+	query.Ordering("name", provider.Descending)
+	query.Ordering("id", provider.Ascending)
+	query.Seek([]interface{}{nil, nil, "e81f509f-38ec-42e8-9a1c-8e527977e526"})
+
+	_, where, _, value, _ := provider.TranslateQuery(query)
+
+	assert.Equal(n.T(), "WHERE ((city.code IS NULL AND city.name IS NOT NULL) OR "+
+		"(city.code IS NULL AND city.name IS NULL AND (city.id > $`city.id.GreaterThan` OR city.id IS NULL)))", where)
+	assert.Equal(n.T(), map[string]interface{}{
+		"city.id.GreaterThan": "e81f509f-38ec-42e8-9a1c-8e527977e526",
+	}, value)
+}
+
+func (n *Neo4JSuite) Test_DecodeCursor_Invalid() {
+	orderings, values, err := provider.DecodeCursor("<-")
+
+	assert.Nil(n.T(), orderings)
+	assert.Nil(n.T(), values)
+	assert.Error(n.T(), err)
+}
+
+func (n *Neo4JSuite) Test_Copy() {
+	query := provider.NewQuery("City")
+	query.Ordering("name", provider.Ascending)
+
+	copied := query.Copy()
+	copied.Filter("code", provider.Equal, "32.73")
+	copied.Reverse()
+
+	assert.Empty(n.T(), query.Filters)
+	assert.Equal(n.T(), provider.Ascending, query.Orderings[0].Direction)
+	assert.Equal(n.T(), provider.Descending, copied.Orderings[0].Direction)
+}
+
+func (n *Neo4JSuite) Test_TranslateProjection() {
+	query := provider.NewQuery("Province")
+	query.Ordering("name", provider.Ascending)
+	query.Select("code", "id")
+	regencies := query.ExpansionOf("regencies", "Regency").Select("name")
+	regencies.ExpansionOf("districts", "District")
+	query.ExpansionOf("regencies", "Regency").Select("code")
+
+	projection, _ := provider.TranslateProjection(query)
+
+	assert.True(n.T(), query.Projected())
+	assert.Equal(n.T(), "province {.id, .code, .name, "+
+		"regencies: [(province)-->(regency:Regency) | regency {.id, .name, .code, "+
+		"districts: [(regency)-->(district:District) | district {.id}]}]}", projection)
+}
+
+func (n *Neo4JSuite) Test_TranslateProjection_Empty() {
+	query := provider.NewQuery("Province")
+
+	assert.False(n.T(), query.Projected())
+	projection, err := provider.TranslateProjection(query)
+
+	assert.NoError(n.T(), err)
+	assert.Equal(n.T(), "province {.id}", projection)
+}
+
+func (n *Neo4JSuite) Test_TranslateQuery_Near() {
+	query := provider.NewQuery("District")
+	query.Incoming(provider.NewQuery("Province").Filter("code", provider.Equal, "32"))
+	query.Ordering("name", provider.Ascending)
+	query.Near("location", -6.89, 107.61, 10000)
+	query.Slice(0, 5)
+
+	match, where, order, value, err := provider.TranslateQuery(query)
+	projection, _ := provider.TranslateProjection(query)
+
+	assert.NoError(n.T(), err)
+	assert.True(n.T(), query.Projected())
+	assert.Equal(n.T(), "(district:District), (district)<-[*]-(province:Province)", match)
+	assert.Equal(n.T(), "WHERE province.code = $`province.code` AND district.location IS NOT NULL AND "+
+		"distance(district.location, point($`district.distance`)) <= $`district.radius`", where)
+	assert.Equal(n.T(), "ORDER BY distance(district.location, point($`district.distance`)) ASC, district.name ASC LIMIT 5", order)
+	assert.Equal(n.T(), map[string]interface{}{
+		"province.code":     "32",
+		"district.distance": map[string]interface{}{"latitude": -6.89, "longitude": 107.61},
+		"district.radius":   10000.0,
+	}, value)
+	assert.Equal(n.T(), "district {.id, .name, distance: distance(district.location, point($`district.distance`))}", projection)
+}
+
+func (n *Neo4JSuite) Test_TranslateQuery_NearWithoutRadius() {
+	query := provider.NewQuery("Province").Near("location", -6.89, 107.61, 0)
+
+	_, where, order, value, _ := provider.TranslateQuery(query)
+
+	assert.Equal(n.T(), "WHERE province.location IS NOT NULL", where)
+	assert.Equal(n.T(), "ORDER BY distance(province.location, point($`province.distance`)) ASC", order)
+	assert.NotContains(n.T(), value, "province.radius")
+}
+
+func (n *Neo4JSuite) Test_TranslateQuery_InvalidNode() {
+	query := provider.NewQuery("Province")
+	query.Incoming(provider.NewQuery("Country) DETACH DELETE (province"))
+
+	_, _, _, _, err := provider.TranslateQuery(query)
+
+	assert.EqualError(n.T(), err, `invalid node label "Country) DETACH DELETE (province"`)
+}
+
+func (n *Neo4JSuite) Test_TranslateFilter_InvalidField() {
+	query := provider.NewQuery("Province").Group(provider.Or,
+		provider.NewFilter("name", provider.Equal, "Aceh"),
+		provider.NewFilter("name = 1 OR 1", provider.Equal, "Bali"))
+
+	_, _, err := provider.TranslateFilter(query, nil, map[string]interface{}{})
+
+	assert.IsType(n.T(), &provider.PropertyError{}, err)
+}
+
+func (n *Neo4JSuite) Test_FullTextQuery() {
+	query := provider.FullTextQuery(`Kab. "Bandung") OR name:*`, nil)
+
+	assert.Equal(n.T(), "+(kab^2 OR kab*) +(bandung^2 OR bandung* OR bandung~) +(or^2 OR or*) +(name^2 OR name* OR name~)", query)
+}
+
+func (n *Neo4JSuite) Test_FullTextQuery_Empty() {
+	assert.Empty(n.T(), provider.FullTextQuery(" -- ", nil))
+}
+
+func (n *Neo4JSuite) Test_FullTextQuery_Alternatives() {
+	query := provider.FullTextQuery("kepulauan seribu", map[string][]string{"kepulauan": {"kep"}})
+
+	assert.Equal(n.T(), "+(kepulauan^2 OR kepulauan* OR kepulauan~ OR kep^2 OR kep*) +(seribu^2 OR seribu* OR seribu~)", query)
+}
+
+func (n *Neo4JSuite) Test_RecordUnmarshal_Point() {
+	var results []struct {
+		Code     string `json:"code"`
+		Location *struct {
+			Lat float64 `json:"lat"`
+			Lng float64 `json:"lng"`
+		} `json:"location"`
+	}
+
+	err := provider.RecordUnmarshal([]interface{}{
+		map[string]interface{}{"code": "32", "location": neo4j.NewPoint2D(4326, 107.669, -7.09)},
+		map[string]interface{}{"code": "33"},
+	}, &results)
+
+	assert.NoError(n.T(), err)
+	assert.Equal(n.T(), -7.09, results[0].Location.Lat)
+	assert.Equal(n.T(), 107.669, results[0].Location.Lng)
+	assert.Nil(n.T(), results[1].Location)
+}
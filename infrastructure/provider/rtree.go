@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"math"
+	"sort"
+)
+
+// rtreeCapacity is the maximum children of one node of the tree
+const rtreeCapacity = 16
+
+type (
+	// Box is the bounding box, x is the longitude and y is the latitude for geographic boxes
+	Box struct {
+		MinX, MinY, MaxX, MaxY float64
+	}
+
+	// RTreeEntry is the value indexed by the bounding box
+	RTreeEntry struct {
+		Box   Box
+		Value interface{}
+	}
+
+	// RTree is the static R-tree of the bounding boxes, packed with Sort-Tile-Recursive so every node is full,
+	// the tree is read only after it's created and safe for concurrent search
+	RTree struct {
+		root *rtreeNode
+		size int
+	}
+
+	rtreeNode struct {
+		box      Box
+		children []*rtreeNode
+		entry    *RTreeEntry
+	}
+)
+
+// Contains checks whether the point is inside or on the edge of the box
+func (b Box) Contains(x, y float64) bool {
+	return x >= b.MinX && x <= b.MaxX && y >= b.MinY && y <= b.MaxY
+}
+
+// Intersects checks whether the boxes overlap or touch
+func (b Box) Intersects(other Box) bool {
+	return b.MinX <= other.MaxX && other.MinX <= b.MaxX && b.MinY <= other.MaxY && other.MinY <= b.MaxY
+}
+
+func (b Box) extend(other Box) Box {
+	return Box{
+		MinX: math.Min(b.MinX, other.MinX),
+		MinY: math.Min(b.MinY, other.MinY),
+		MaxX: math.Max(b.MaxX, other.MaxX),
+		MaxY: math.Max(b.MaxY, other.MaxY),
+	}
+}
+
+func (b Box) center() (float64, float64) {
+	return (b.MinX + b.MaxX) / 2, (b.MinY + b.MaxY) / 2
+}
+
+// NewRTree packs the entries to the tree
+func NewRTree(entries []*RTreeEntry) *RTree {
+	nodes := make([]*rtreeNode, 0, len(entries))
+	for _, entry := range entries {
+		nodes = append(nodes, &rtreeNode{box: entry.Box, entry: entry})
+	}
+
+	for len(nodes) > rtreeCapacity {
+		nodes = pack(nodes)
+	}
+
+	tree := &RTree{size: len(entries)}
+	if len(nodes) > 0 {
+		tree.root = parent(nodes)
+	}
+
+	return tree
+}
+
+// Len returns the number of the entries
+func (t *RTree) Len() int {
+	return t.size
+}
+
+// Search returns the values of the boxes contain the point
+func (t *RTree) Search(x, y float64) []interface{} {
+	return t.SearchBox(Box{MinX: x, MinY: y, MaxX: x, MaxY: y})
+}
+
+// SearchBox returns the values of the boxes intersect the box
+func (t *RTree) SearchBox(box Box) []interface{} {
+	if t.root == nil {
+		return nil
+	}
+
+	var values []interface{}
+	stack := []*rtreeNode{t.root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if !node.box.Intersects(box) {
+			continue
+		}
+
+		if node.entry != nil {
+			values = append(values, node.entry.Value)
+			continue
+		}
+
+		stack = append(stack, node.children...)
+	}
+
+	return values
+}
+
+// pack groups the nodes to the upper level, the nodes are sorted to vertical slices by x
+// then every slice is sorted by y and split to full nodes
+func pack(nodes []*rtreeNode) []*rtreeNode {
+	count := int(math.Ceil(float64(len(nodes)) / rtreeCapacity))
+	slices := int(math.Ceil(math.Sqrt(float64(count))))
+	size := slices * rtreeCapacity
+
+	sort.Slice(nodes, func(i, j int) bool {
+		x1, _ := nodes[i].box.center()
+		x2, _ := nodes[j].box.center()
+		return x1 < x2
+	})
+
+	parents := make([]*rtreeNode, 0, count)
+	for start := 0; start < len(nodes); start += size {
+		slice := nodes[start:minInt(start+size, len(nodes))]
+
+		sort.Slice(slice, func(i, j int) bool {
+			_, y1 := slice[i].box.center()
+			_, y2 := slice[j].box.center()
+			return y1 < y2
+		})
+
+		for i := 0; i < len(slice); i += rtreeCapacity {
+			parents = append(parents, parent(slice[i:minInt(i+rtreeCapacity, len(slice))]))
+		}
+	}
+
+	return parents
+}
+
+func parent(children []*rtreeNode) *rtreeNode {
+	node := &rtreeNode{
+		box:      children[0].box,
+		children: append([]*rtreeNode(nil), children...),
+	}
+	for _, child := range children[1:] {
+		node.box = node.box.extend(child.box)
+	}
+
+	return node
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,72 @@
+package provider_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type RTreeSuite struct {
+	suite.Suite
+}
+
+func Test_RTreeSuite(t *testing.T) {
+	suite.Run(t, new(RTreeSuite))
+}
+
+func (r *RTreeSuite) Test_Search_Grid() {
+	var entries []*provider.RTreeEntry
+	for x := 0; x < 40; x++ {
+		for y := 0; y < 40; y++ {
+			entries = append(entries, &provider.RTreeEntry{
+				Box:   provider.Box{MinX: float64(x), MinY: float64(y), MaxX: float64(x) + 1, MaxY: float64(y) + 1},
+				Value: x*100 + y,
+			})
+		}
+	}
+
+	tree := provider.NewRTree(entries)
+
+	assert.Equal(r.T(), 1600, tree.Len())
+	assert.Equal(r.T(), []interface{}{1217}, tree.Search(12.5, 17.5))
+
+	values := tree.Search(12, 17)
+	sort.Slice(values, func(i, j int) bool { return values[i].(int) < values[j].(int) })
+	assert.Equal(r.T(), []interface{}{1116, 1117, 1216, 1217}, values)
+
+	assert.Empty(r.T(), tree.Search(41, 17))
+}
+
+func (r *RTreeSuite) Test_Search_Overlap() {
+	tree := provider.NewRTree([]*provider.RTreeEntry{
+		{Box: provider.Box{MinX: 95, MinY: -11, MaxX: 141, MaxY: 6}, Value: "country"},
+		{Box: provider.Box{MinX: 106, MinY: -8, MaxX: 109, MaxY: -5.9}, Value: "province"},
+		{Box: provider.Box{MinX: 107.5, MinY: -7, MaxX: 107.8, MaxY: -6.8}, Value: "city"},
+	})
+
+	assert.ElementsMatch(r.T(), []interface{}{"country", "province", "city"}, tree.Search(107.6, -6.9))
+	assert.ElementsMatch(r.T(), []interface{}{"country"}, tree.Search(120, 0))
+}
+
+func (r *RTreeSuite) Test_SearchBox() {
+	tree := provider.NewRTree([]*provider.RTreeEntry{
+		{Box: provider.Box{MinX: 0, MinY: 0, MaxX: 1, MaxY: 1}, Value: "west"},
+		{Box: provider.Box{MinX: 1, MinY: 0, MaxX: 2, MaxY: 1}, Value: "east"},
+		{Box: provider.Box{MinX: 5, MinY: 5, MaxX: 6, MaxY: 6}, Value: "island"},
+	})
+
+	assert.ElementsMatch(r.T(), []interface{}{"west", "east"}, tree.SearchBox(provider.Box{MinX: 0, MinY: 0, MaxX: 1, MaxY: 1}))
+	assert.ElementsMatch(r.T(), []interface{}{"island"}, tree.SearchBox(provider.Box{MinX: 4, MinY: 4, MaxX: 5.5, MaxY: 7}))
+	assert.Empty(r.T(), tree.SearchBox(provider.Box{MinX: 3, MinY: 3, MaxX: 4, MaxY: 4}))
+}
+
+func (r *RTreeSuite) Test_Search_Empty() {
+	tree := provider.NewRTree(nil)
+
+	assert.Equal(r.T(), 0, tree.Len())
+	assert.Empty(r.T(), tree.Search(0, 0))
+}
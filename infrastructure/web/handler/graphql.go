@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/dynastymasra/cartographer/config"
+
+	"github.com/dynastymasra/cookbook"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	graph "github.com/graphql-go/handler"
+	"github.com/sirupsen/logrus"
+)
+
+// ContentTypeGraphQLResponse is the media type of the GraphQL over HTTP response,
+// client opts in the spec compliant response by sending it in the Accept header
+const ContentTypeGraphQLResponse = "application/graphql-response+json"
+
+// GraphQL executes the request to the schema, the first service error is used as the response
+// unless the client accepts the spec compliant response
+func GraphQL(schema graphql.Schema) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.Header.Get("Accept"), ContentTypeGraphQLResponse) {
+			SpecGraphQL(schema)(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		requestID := r.Context().Value(cookbook.RequestID).(string)
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: requestID,
+			"package":          runtime.FuncForPC(reflect.ValueOf(GraphQL).Pointer()).Name(),
+		})
+
+		req := graph.NewRequestOptions(r)
+
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  req.Query,
+			VariableValues: req.Variables,
+			OperationName:  req.OperationName,
+			Context:        r.Context(),
+		})
+
+		if len(result.Errors) > 0 {
+			var errs []cookbook.JSON
+			for _, err := range result.Errors {
+				log.WithError(err).Warnln("Failed process request")
+
+				switch err := err.OriginalError().(type) {
+				case *gqlerrors.Error:
+					if err, ok := err.OriginalError.(*config.ServiceError); ok {
+						if err.Code() >= http.StatusInternalServerError {
+							log.WithError(err).Errorln("Failed process data from storage")
+						}
+
+						config.ParseToJSON(err, w, requestID)
+						return
+					}
+					errs = append(errs, cookbook.JSON{
+						"message": err.Error(),
+					})
+				default:
+					errs = append(errs, cookbook.JSON{
+						"message": err.Error(),
+					})
+				}
+			}
+
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, cookbook.FailResponse(&cookbook.JSON{"errors": errs}, requestID).Stringify())
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, cookbook.SuccessDataResponse(result.Data, nil).Stringify())
+	}
+}
+
+// SpecGraphQL executes the request to the schema and responds with the standard data and errors,
+// data of the other fields is kept when a field fails
+func SpecGraphQL(schema graphql.Schema) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeGraphQLResponse)
+
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: r.Context().Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(SpecGraphQL).Pointer()).Name(),
+		})
+
+		req := graph.NewRequestOptions(r)
+
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  req.Query,
+			VariableValues: req.Variables,
+			OperationName:  req.OperationName,
+			Context:        r.Context(),
+		})
+
+		for i, err := range result.Errors {
+			log.WithError(err).Warnln("Failed process request")
+
+			if len(err.Extensions) > 0 {
+				if status, ok := err.Extensions["status"].(int); ok && status >= http.StatusInternalServerError {
+					log.WithError(err).Errorln("Failed process data from storage")
+				}
+				continue
+			}
+
+			// Error without path is raised when parse or validate the document
+			code := "INTERNAL_SERVER_ERROR"
+			if len(err.Path) < 1 {
+				code = "GRAPHQL_VALIDATION_FAILED"
+			}
+			result.Errors[i].Extensions = map[string]interface{}{"code": code}
+		}
+
+		// Data is empty when the document is invalid
+		if result.Data == nil {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			log.WithError(err).Errorln("Failed write response")
+		}
+	}
+}
+
+// Query merges root fields of every module into one root query type
+func Query(fields ...graphql.Fields) *graphql.Object {
+	query := graphql.Fields{}
+
+	for _, field := range fields {
+		for name, config := range field {
+			query[name] = config
+		}
+	}
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Query",
+		Description: "Query country and administrative division data from storage",
+		Fields:      query,
+	})
+}
@@ -0,0 +1,225 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/config"
+	"github.com/dynastymasra/cartographer/domain"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+	"github.com/dynastymasra/cartographer/infrastructure/web/handler"
+
+	countryHandler "github.com/dynastymasra/cartographer/country/handler"
+	countryTest "github.com/dynastymasra/cartographer/country/test"
+	regionHandler "github.com/dynastymasra/cartographer/region/handler"
+	regionTest "github.com/dynastymasra/cartographer/region/test"
+
+	"github.com/dynastymasra/cookbook"
+	"github.com/graphql-go/graphql"
+	graph "github.com/graphql-go/handler"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type GraphQLSuite struct {
+	suite.Suite
+	regionRepo  *regionTest.MockRepository
+	countryRepo *countryTest.MockRepository
+	schema      graphql.Schema
+}
+
+func Test_GraphQLSuite(t *testing.T) {
+	suite.Run(t, new(GraphQLSuite))
+}
+
+func (g *GraphQLSuite) SetupSuite() {
+	config.SetupTestLogger()
+}
+
+func (g *GraphQLSuite) SetupTest() {
+	g.regionRepo = &regionTest.MockRepository{}
+	g.countryRepo = &countryTest.MockRepository{}
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.Query(
+			regionHandler.RegionFields(g.regionRepo),
+			countryHandler.CountryFields(g.countryRepo),
+		),
+	})
+	if err != nil {
+		g.T().Fatal(err)
+	}
+	g.schema = schema
+}
+
+func (g *GraphQLSuite) Test_GraphQL_Success() {
+	body := []byte(`{"query":"{country(ISO3166Alpha2: \"ID\") {name provinces {name country {name} regencies {name province {name}}}} ` +
+		`province(code: \"32\") {name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	query := provider.NewQuery("Country")
+	query.Filter("ISO3166Alpha2", provider.Equal, "ID")
+
+	country := &domain.Country{
+		Name: "Indonesia",
+		Regions: domain.Regions{
+			domain.ProvinceNode: []*domain.Region{
+				{
+					Name: "Jawa Barat",
+					Regions: domain.Regions{
+						domain.RegencyNode: []*domain.Region{{Name: "Bandung"}},
+					},
+				},
+			},
+		},
+	}
+	g.countryRepo.On("Find", ctx, query).Return(country, nil)
+
+	query2 := provider.NewQuery("Province")
+	query2.Filter("code", provider.Equal, "32")
+	query2.Select("name")
+
+	g.regionRepo.On("Find", ctx, query2).Return(&domain.Region{Name: "Jawa Barat"}, nil)
+
+	handler.GraphQL(g.schema)(w, req.WithContext(ctx))
+
+	assert.Equal(g.T(), http.StatusOK, w.Code)
+	assert.Contains(g.T(), w.Body.String(), `"provinces":[{"country":{"name":"Indonesia"},"name":"Jawa Barat",`+
+		`"regencies":[{"name":"Bandung","province":{"name":"Jawa Barat"}}]}]`)
+	assert.Contains(g.T(), w.Body.String(), `"province":{"name":"Jawa Barat"}`)
+}
+
+func (g *GraphQLSuite) Test_GraphQL_InvalidQuery() {
+	body := []byte(`{"query":"{country(ISO3166Alpha2: \"ID\") {unknown}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	handler.GraphQL(g.schema)(w, req.WithContext(ctx))
+
+	assert.Equal(g.T(), http.StatusBadRequest, w.Code)
+}
+
+func (g *GraphQLSuite) Test_GraphQL_ServiceError() {
+	body := []byte(`{"query":"{country {name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	handler.GraphQL(g.schema)(w, req.WithContext(ctx))
+
+	assert.Equal(g.T(), http.StatusPreconditionFailed, w.Code)
+}
+
+func (g *GraphQLSuite) Test_Schema_TypeNames() {
+	for _, name := range []string{
+		"Province", "City", "Regency", "District", "Village", "Country", "Currency",
+		"ProvinceInput", "CityInput", "RegencyInput", "DistrictInput", "CountryInput", "CurrencyInput",
+		"AdministrativeDivision", "Ancestor",
+	} {
+		assert.NotNil(g.T(), g.schema.Type(name), name)
+	}
+
+	assert.Len(g.T(), g.schema.PossibleTypes(domain.AdministrativeDivisionInterface), 5)
+}
+
+func (g *GraphQLSuite) Test_GraphQL_InterfaceFragment() {
+	body := []byte(`{"query":"{country(ISO3166Alpha2: \"ID\") {provinces {regencies {path {... on AdministrativeDivision {code}}}}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	query := provider.NewQuery("Country")
+	query.Filter("ISO3166Alpha2", provider.Equal, "ID")
+
+	country := &domain.Country{
+		Name: "Indonesia",
+		Regions: domain.Regions{
+			domain.ProvinceNode: []*domain.Region{
+				{
+					Code: "32",
+					Regions: domain.Regions{
+						domain.RegencyNode: []*domain.Region{{Code: "32.04"}},
+					},
+				},
+			},
+		},
+	}
+	g.countryRepo.On("Find", ctx, query).Return(country, nil)
+
+	handler.GraphQL(g.schema)(w, req.WithContext(ctx))
+
+	assert.Equal(g.T(), http.StatusOK, w.Code)
+	assert.Contains(g.T(), w.Body.String(), `"path":[{},{"code":"32"}]`)
+}
+
+func (g *GraphQLSuite) Test_SpecGraphQL_PartialData() {
+	body := []byte(`{"query":"{province(code: \"32\") {name} city(code: \"99.99\") {name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+	req.Header.Set("Accept", handler.ContentTypeGraphQLResponse)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	query := provider.NewQuery("Province")
+	query.Filter("code", provider.Equal, "32")
+	query.Select("name")
+
+	g.regionRepo.On("Find", ctx, query).Return(&domain.Region{Name: "Jawa Barat"}, nil)
+
+	query2 := provider.NewQuery("City")
+	query2.Filter("code", provider.Equal, "99.99")
+	query2.Select("name")
+
+	g.regionRepo.On("Find", ctx, query2).Return((*domain.Region)(nil), errors.New(provider.ErrorRecordNotFound))
+
+	handler.GraphQL(g.schema)(w, req.WithContext(ctx))
+
+	assert.Equal(g.T(), http.StatusOK, w.Code)
+	assert.Equal(g.T(), handler.ContentTypeGraphQLResponse, w.Header().Get("Content-Type"))
+	assert.JSONEq(g.T(), `{
+		"data": {"province": {"name": "Jawa Barat"}, "city": null},
+		"errors": [{
+			"message": "`+provider.ErrorRecordNotFound+`",
+			"locations": [{"line": 1, "column": 30}],
+			"path": ["city"],
+			"extensions": {"code": "NOT_FOUND", "key": "city", "status": 404}
+		}]
+	}`, w.Body.String())
+}
+
+func (g *GraphQLSuite) Test_SpecGraphQL_InvalidQuery() {
+	body := []byte(`{"query":"{country(ISO3166Alpha2: \"ID\") {unknown}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	handler.SpecGraphQL(g.schema)(w, req.WithContext(ctx))
+
+	assert.Equal(g.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(g.T(), w.Body.String(), `"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}`)
+}
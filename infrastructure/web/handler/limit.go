@@ -0,0 +1,301 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dynastymasra/cartographer/config"
+
+	"github.com/dynastymasra/cookbook"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	graph "github.com/graphql-go/handler"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/negroni"
+)
+
+// paginationArgs are arguments used as weight of the list field
+var paginationArgs = []string{"limit", "first", "last"}
+
+// Limit is maximum depth, complexity, page size and body size in bytes of the GraphQL document
+type Limit struct {
+	MaxDepth      int
+	MaxComplexity int
+	MaxLimit      int
+	MaxBodySize   int64
+}
+
+// QueryLimit rejects the document exceeds the limit before executed, so no storage session is opened
+func QueryLimit(schema graphql.Schema, limit Limit) negroni.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		requestID, _ := r.Context().Value(cookbook.RequestID).(string)
+		log := logrus.WithField(cookbook.RequestID, requestID)
+
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, limit.MaxBodySize))
+		if err != nil {
+			// Reader stops at the maximum size, the body is only read partially when it's bigger
+			if int64(len(body)) >= limit.MaxBodySize {
+				log.WithError(err).Warnln("Request body exceeds the limit")
+				rejectQuery(w, r, requestID, config.NewError(http.StatusRequestEntityTooLarge, "body",
+					fmt.Sprintf("request body exceeds the maximum size %d bytes", limit.MaxBodySize)))
+				return
+			}
+			log.WithError(err).Warnln("Failed read request body")
+		}
+
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req := graph.NewRequestOptions(r)
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		if err := limit.Check(schema, req); err != nil {
+			log.WithError(err).WithField("query", req.Query).Warnln("Query exceeds the limit")
+			rejectQuery(w, r, requestID, err)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// rejectQuery writes the error in the format accepted by the client
+func rejectQuery(w http.ResponseWriter, r *http.Request, requestID string, err *config.ServiceError) {
+	if !strings.Contains(r.Header.Get("Accept"), ContentTypeGraphQLResponse) {
+		w.Header().Set("Content-Type", "application/json")
+		config.ParseToJSON(err, w, requestID)
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentTypeGraphQLResponse)
+	w.WriteHeader(err.Code())
+	if err := json.NewEncoder(w).Encode(&graphql.Result{
+		Errors: []gqlerrors.FormattedError{{
+			Message:    err.Error(),
+			Extensions: err.Extensions(),
+		}},
+	}); err != nil {
+		logrus.WithField(cookbook.RequestID, requestID).WithError(err).Errorln("Failed write response")
+	}
+}
+
+// Check calculates depth, complexity and page size of the operation, invalid document is left to the executor
+func (l Limit) Check(schema graphql.Schema, req *graph.RequestOptions) *config.ServiceError {
+	document, err := parser.Parse(parser.ParseParams{Source: req.Query})
+	if err != nil {
+		return nil
+	}
+
+	walker := &walker{
+		schema:    schema,
+		limit:     l,
+		variables: req.Variables,
+		fragments: map[string]*ast.FragmentDefinition{},
+	}
+
+	var operation *ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.FragmentDefinition:
+			walker.fragments[definition.Name.Value] = definition
+		case *ast.OperationDefinition:
+			if operation == nil || (definition.Name != nil && definition.Name.Value == req.OperationName) {
+				operation = definition
+			}
+		}
+	}
+
+	if operation == nil {
+		return nil
+	}
+
+	depth, complexity, limitErr := walker.walk(operation.SelectionSet, schema.QueryType(), 1, map[string]bool{})
+	if limitErr != nil {
+		return limitErr
+	}
+
+	if depth > l.MaxDepth {
+		return config.NewError(http.StatusBadRequest, "depth",
+			fmt.Sprintf("query depth %d exceeds the maximum depth %d", depth, l.MaxDepth))
+	}
+
+	if complexity > l.MaxComplexity {
+		return config.NewError(http.StatusBadRequest, "complexity",
+			fmt.Sprintf("query complexity %d exceeds the maximum complexity %d", complexity, l.MaxComplexity))
+	}
+
+	return nil
+}
+
+type walker struct {
+	schema    graphql.Schema
+	limit     Limit
+	variables map[string]interface{}
+	fragments map[string]*ast.FragmentDefinition
+}
+
+// walk returns the deepest level and the total cost of the selection set, visited prevents fragment cycle
+func (w *walker) walk(set *ast.SelectionSet, parent graphql.Type, level int, visited map[string]bool) (int, int, *config.ServiceError) {
+	if set == nil {
+		return level - 1, 0, nil
+	}
+
+	depth, complexity := level-1, 0
+	for _, selection := range set.Selections {
+		var d, c int
+		var err *config.ServiceError
+
+		switch selection := selection.(type) {
+		case *ast.Field:
+			d, c, err = w.field(selection, parent, level, visited)
+		case *ast.InlineFragment:
+			typ := parent
+			if selection.TypeCondition != nil {
+				typ = w.schema.Type(selection.TypeCondition.Name.Value)
+			}
+			d, c, err = w.walk(selection.SelectionSet, typ, level, visited)
+		case *ast.FragmentSpread:
+			fragment, ok := w.fragments[selection.Name.Value]
+			if !ok || visited[selection.Name.Value] {
+				continue
+			}
+			visited[selection.Name.Value] = true
+			d, c, err = w.walk(fragment.SelectionSet, w.schema.Type(fragment.TypeCondition.Name.Value), level, visited)
+			delete(visited, selection.Name.Value)
+		}
+
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if d > depth {
+			depth = d
+		}
+		complexity += c
+	}
+
+	return depth, complexity, nil
+}
+
+// field costs one, list field is weighted by the page size and the introspection fields are free
+func (w *walker) field(field *ast.Field, parent graphql.Type, level int, visited map[string]bool) (int, int, *config.ServiceError) {
+	if strings.HasPrefix(field.Name.Value, "__") {
+		return level, 0, nil
+	}
+
+	var definition *graphql.FieldDefinition
+	switch parent := parent.(type) {
+	case *graphql.Object:
+		definition = parent.Fields()[field.Name.Value]
+	case *graphql.Interface:
+		definition = parent.Fields()[field.Name.Value]
+	}
+
+	var typ graphql.Type
+	if definition != nil {
+		typ = definition.Type
+	}
+
+	// Paginated and list fields return the default page size, edges are weighted by the connection field
+	weight := 1
+	if paginated(definition) || (list(typ) && field.Name.Value != "edges") {
+		weight = config.Limit
+	}
+
+	for _, arg := range field.Arguments {
+		for _, name := range paginationArgs {
+			if arg.Name.Value != name {
+				continue
+			}
+
+			size, ok := w.value(arg.Value)
+			if !ok {
+				continue
+			}
+
+			if size < 1 {
+				return 0, 0, config.NewError(http.StatusBadRequest, name,
+					fmt.Sprintf("%s %d must be greater than zero", name, size))
+			}
+
+			if size > w.limit.MaxLimit {
+				return 0, 0, config.NewError(http.StatusBadRequest, name,
+					fmt.Sprintf("%s %d exceeds the maximum %s %d", name, size, name, w.limit.MaxLimit))
+			}
+
+			weight = size
+		}
+	}
+
+	depth, complexity, err := w.walk(field.SelectionSet, named(typ), level+1, visited)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if field.SelectionSet == nil {
+		depth = level
+	}
+
+	return depth, weight * (1 + complexity), nil
+}
+
+// value reads integer of the argument, the variable value is decoded from JSON as float
+func (w *walker) value(value ast.Value) (int, bool) {
+	switch value := value.(type) {
+	case *ast.IntValue:
+		size, err := strconv.Atoi(value.Value)
+		return size, err == nil
+	case *ast.Variable:
+		switch v := w.variables[value.Name.Value].(type) {
+		case float64:
+			return int(v), true
+		case int:
+			return v, true
+		}
+	}
+
+	return 0, false
+}
+
+func paginated(definition *graphql.FieldDefinition) bool {
+	if definition == nil {
+		return false
+	}
+
+	for _, arg := range definition.Args {
+		for _, name := range paginationArgs {
+			if arg.Name() == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func list(typ graphql.Type) bool {
+	if nonNull, ok := typ.(*graphql.NonNull); ok {
+		typ = nonNull.OfType
+	}
+
+	_, ok := typ.(*graphql.List)
+	return ok
+}
+
+func named(typ graphql.Type) graphql.Type {
+	for {
+		switch t := typ.(type) {
+		case *graphql.NonNull:
+			typ = t.OfType
+		case *graphql.List:
+			typ = t.OfType
+		default:
+			return typ
+		}
+	}
+}
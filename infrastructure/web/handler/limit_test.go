@@ -0,0 +1,182 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/config"
+	"github.com/dynastymasra/cartographer/infrastructure/web/handler"
+
+	countryHandler "github.com/dynastymasra/cartographer/country/handler"
+	regionHandler "github.com/dynastymasra/cartographer/region/handler"
+
+	"github.com/dynastymasra/cookbook"
+	"github.com/graphql-go/graphql"
+	graph "github.com/graphql-go/handler"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type LimitSuite struct {
+	suite.Suite
+	schema graphql.Schema
+	limit  handler.Limit
+}
+
+func Test_LimitSuite(t *testing.T) {
+	suite.Run(t, new(LimitSuite))
+}
+
+func (l *LimitSuite) SetupSuite() {
+	config.SetupTestLogger()
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.Query(regionHandler.RegionFields(nil), countryHandler.CountryFields(nil)),
+	})
+	if err != nil {
+		l.T().Fatal(err)
+	}
+	l.schema = schema
+	l.limit = handler.Limit{MaxDepth: 4, MaxComplexity: 1000, MaxLimit: 100, MaxBodySize: 1024}
+}
+
+func (l *LimitSuite) Test_Check_Success() {
+	err := l.limit.Check(l.schema, &graph.RequestOptions{
+		Query: `{villages(limit: 30) {name district {name}} __schema {types {fields {type {ofType {name}}}}}}`,
+	})
+
+	assert.Nil(l.T(), err)
+}
+
+func (l *LimitSuite) Test_Check_Depth() {
+	err := l.limit.Check(l.schema, &graph.RequestOptions{
+		Query: `query {province(code: "32") {...children}} fragment children on Province {regencies {districts {villages {name}}}}`,
+	})
+
+	assert.Equal(l.T(), "depth", err.Key())
+	assert.Equal(l.T(), "query depth 5 exceeds the maximum depth 4", err.Error())
+}
+
+func (l *LimitSuite) Test_Check_Complexity() {
+	err := l.limit.Check(l.schema, &graph.RequestOptions{
+		Query: `{provinces {regencies {districts {name}}}}`,
+	})
+
+	assert.Equal(l.T(), "complexity", err.Key())
+	assert.Equal(l.T(), http.StatusBadRequest, err.Code())
+}
+
+func (l *LimitSuite) Test_Check_ConnectionComplexity() {
+	err := l.limit.Check(l.schema, &graph.RequestOptions{
+		Query: `{villagesConnection(first: 10) {edges {node {name code}}}}`,
+	})
+
+	assert.Nil(l.T(), err)
+}
+
+func (l *LimitSuite) Test_Check_Limit() {
+	err := l.limit.Check(l.schema, &graph.RequestOptions{
+		Query:     `query ($first: Int) {villagesConnection(first: $first) {edges {node {name}}}}`,
+		Variables: map[string]interface{}{"first": float64(500)},
+	})
+
+	assert.Equal(l.T(), "first", err.Key())
+	assert.Equal(l.T(), "first 500 exceeds the maximum first 100", err.Error())
+}
+
+func (l *LimitSuite) Test_Check_NegativeLimit() {
+	err := l.limit.Check(l.schema, &graph.RequestOptions{
+		Query: `{a: villages(limit: 100) {name} b: villages(limit: -100) {name} c: villages(limit: 100) {name}}`,
+	})
+
+	assert.Equal(l.T(), "limit", err.Key())
+	assert.Equal(l.T(), "limit -100 must be greater than zero", err.Error())
+}
+
+func (l *LimitSuite) Test_Check_ZeroFirst() {
+	err := l.limit.Check(l.schema, &graph.RequestOptions{
+		Query: `{villagesConnection(first: 0) {edges {node {name}}}}`,
+	})
+
+	assert.Equal(l.T(), "first", err.Key())
+}
+
+func (l *LimitSuite) Test_QueryLimit_Reject() {
+	body := []byte(`{"query":"{villages(limit: 1000) {name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	handler.QueryLimit(l.schema, l.limit)(w, req.WithContext(ctx), func(w http.ResponseWriter, r *http.Request) {
+		l.T().Fatal("next handler must not be called")
+	})
+
+	assert.Equal(l.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(l.T(), w.Body.String(), `"limit":"limit 1000 exceeds the maximum limit 100"`)
+}
+
+func (l *LimitSuite) Test_QueryLimit_RejectSpec() {
+	body := []byte(`{"query":"{villages(limit: 1000) {name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+	req.Header.Set("Accept", handler.ContentTypeGraphQLResponse)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	handler.QueryLimit(l.schema, l.limit)(w, req.WithContext(ctx), func(w http.ResponseWriter, r *http.Request) {
+		l.T().Fatal("next handler must not be called")
+	})
+
+	assert.Equal(l.T(), http.StatusBadRequest, w.Code)
+	assert.JSONEq(l.T(), `{"data": null, "errors": [{"message": "limit 1000 exceeds the maximum limit 100", "locations": null, `+
+		`"extensions": {"code": "BAD_REQUEST", "key": "limit", "status": 400}}]}`, w.Body.String())
+}
+
+func (l *LimitSuite) Test_QueryLimit_BodySize() {
+	body := []byte(`{"query":"{villages(limit: 10) {name}}", "variables": {"text": "` + strings.Repeat("a", 1024) + `"}}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	handler.QueryLimit(l.schema, l.limit)(w, req.WithContext(ctx), func(w http.ResponseWriter, r *http.Request) {
+		l.T().Fatal("next handler must not be called")
+	})
+
+	assert.Equal(l.T(), http.StatusRequestEntityTooLarge, w.Code)
+	assert.Contains(l.T(), w.Body.String(), `"body":"request body exceeds the maximum size 1024 bytes"`)
+}
+
+func (l *LimitSuite) Test_QueryLimit_Next() {
+	body := []byte(`{"query":"{villages(limit: 10) {name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	called := false
+	handler.QueryLimit(l.schema, l.limit)(w, req.WithContext(ctx), func(w http.ResponseWriter, r *http.Request) {
+		called = true
+
+		read, err := ioutil.ReadAll(r.Body)
+		assert.NoError(l.T(), err)
+		assert.Equal(l.T(), body, read)
+	})
+
+	assert.True(l.T(), called)
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/dynastymasra/cartographer/config"
 	"github.com/dynastymasra/cartographer/country"
+	"github.com/dynastymasra/cartographer/domain"
 	"github.com/dynastymasra/cartographer/infrastructure/web/handler"
 	"github.com/dynastymasra/cartographer/region"
 
@@ -31,14 +33,12 @@ type RouterInstance struct {
 }
 
 type GraphSchema struct {
-	region  graphql.Schema
-	country graphql.Schema
+	graph graphql.Schema
 }
 
-func NewSchema(region graphql.Schema, country graphql.Schema) *GraphSchema {
+func NewSchema(graph graphql.Schema) *GraphSchema {
 	return &GraphSchema{
-		region:  region,
-		country: country,
+		graph: graph,
 	}
 }
 
@@ -90,13 +90,43 @@ func (r *RouterInstance) Router() *mux.Router {
 	subRouter := router.PathPrefix("/v1/").Subrouter().UseEncodedPath()
 	commonHandlers.Use(middleware.LogrusLog(r.name))
 
+	queryLimit := handler.QueryLimit(r.schema.graph, handler.Limit{
+		MaxDepth:      config.GraphQL().MaxDepth(),
+		MaxComplexity: config.GraphQL().MaxComplexity(),
+		MaxLimit:      config.GraphQL().MaxLimit(),
+		MaxBodySize:   config.GraphQL().MaxBodySize(),
+	})
+
+	subRouter.Handle("/graphql", commonHandlers.With(
+		queryLimit,
+		negroni.HandlerFunc(r.loaders),
+		negroni.WrapFunc(handler.GraphQL(r.schema.graph)),
+	)).Methods(http.MethodPost)
+
+	subRouter.Handle("/geojson/{level}", commonHandlers.With(
+		negroni.WrapFunc(regionHandler.ExportGeometry(r.regionRepo)),
+	)).Methods(http.MethodGet)
+
+	// Deprecated endpoints, kept as aliases of the unified endpoint
 	subRouter.Handle("/regions", commonHandlers.With(
-		negroni.WrapFunc(regionHandler.FindRegion(r.schema.region)),
+		queryLimit,
+		negroni.HandlerFunc(r.loaders),
+		negroni.WrapFunc(regionHandler.FindRegion(r.schema.graph)),
 	)).Methods(http.MethodPost)
 
 	subRouter.Handle("/countries", commonHandlers.With(
-		negroni.WrapFunc(countryHandler.FindCountry(r.schema.country)),
+		queryLimit,
+		negroni.HandlerFunc(r.loaders),
+		negroni.WrapFunc(countryHandler.FindCountry(r.schema.graph)),
 	)).Methods(http.MethodPost)
 
 	return router
 }
+
+// loaders attaches new batching loaders to the request, so the nested lookups of the request are batched together
+func (r *RouterInstance) loaders(w http.ResponseWriter, req *http.Request, next http.HandlerFunc) {
+	ctx := domain.WithRegionLoader(req.Context(), region.NewLoader(r.regionRepo))
+	ctx = domain.WithCountryLoader(ctx, country.NewLoader(r.countryRepo))
+
+	next(w, req.WithContext(ctx))
+}
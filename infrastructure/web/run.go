@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/dynastymasra/cartographer/country"
 	countryHandler "github.com/dynastymasra/cartographer/country/handler"
+	"github.com/dynastymasra/cartographer/region"
 
 	"github.com/graphql-go/graphql"
 
+	"github.com/dynastymasra/cartographer/infrastructure/web/handler"
 	regionHandler "github.com/dynastymasra/cartographer/region/handler"
 	"github.com/gorilla/handlers"
 	"github.com/sirupsen/logrus"
@@ -22,21 +25,12 @@ func Run(server *graceful.Server, port string, router *RouterInstance) {
 
 	log.Infoln("Start run web application")
 
-	regionSchema, err := graphql.NewSchema(graphql.SchemaConfig{
-		Query: regionHandler.RegionQuery(router.regionRepo),
-	})
-	if err != nil {
-		log.WithError(err).Fatalln("Cannot create new region graph schema")
-	}
-
-	countrySchema, err := graphql.NewSchema(graphql.SchemaConfig{
-		Query: countryHandler.CountryQuery(router.countryRepo),
-	})
+	schema, err := BuildSchema(router.regionRepo, router.countryRepo)
 	if err != nil {
-		log.WithError(err).Fatalln("Cannot create new country graph schema")
+		log.WithError(err).Fatalln("Cannot create new graph schema")
 	}
 
-	router.InsertSchema(NewSchema(regionSchema, countrySchema))
+	router.InsertSchema(NewSchema(schema))
 
 	muxRouter := router.Router()
 
@@ -52,3 +46,14 @@ func Run(server *graceful.Server, port string, router *RouterInstance) {
 		log.WithError(err).Fatalln("Failed to start server")
 	}
 }
+
+// BuildSchema creates the unified schema, region and country share one schema
+// and the old endpoints are aliases of the unified endpoint
+func BuildSchema(regionRepo region.Repository, countryRepo country.Repository) (graphql.Schema, error) {
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.Query(
+			regionHandler.RegionFields(regionRepo),
+			countryHandler.CountryFields(countryRepo),
+		),
+	})
+}
@@ -7,8 +7,11 @@ import (
 	"syscall"
 
 	"github.com/dynastymasra/cartographer/country"
+	"github.com/dynastymasra/cartographer/domain"
 	"github.com/dynastymasra/cartographer/infrastructure/web"
 	"github.com/dynastymasra/cartographer/region"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/neo4j/neo4j-go-driver/neo4j"
 	"gopkg.in/tylerb/graceful.v1"
 
 	"github.com/dynastymasra/cartographer/config"
@@ -32,25 +35,47 @@ func main() {
 		"version":      config.Version,
 	})
 
-	log.Infoln("Prepare start service")
-
-	driver, err := config.Neo4J().Driver()
-	if err != nil {
-		log.WithError(err).Fatalln("Failed create neo4j driver")
-	}
-
-	migration, err := console.Migration(driver)
-	if err != nil {
-		log.WithError(err).Fatalln("Failed run migration")
-	}
-
-	regionRepo := region.NewRepository(driver)
-	countryRepo := country.NewRepository(driver)
+	var (
+		driver      neo4j.Driver
+		migration   *migrate.Migrate
+		regionRepo  region.Repository
+		countryRepo country.Repository
+	)
 
 	clientApp := cli.NewApp()
 	clientApp.Name = config.ServiceName
 	clientApp.Version = config.Version
 
+	// The schema is printed from the GraphQL types only, so it's printed without the database e.g. in CI
+	clientApp.Before = func(c *cli.Context) error {
+		// Levels of the invalid hierarchy file are replaced by the default levels, so it must not start
+		if err := domain.HierarchyError(); err != nil {
+			log.WithError(err).Fatalln("Failed load administrative division levels")
+		}
+
+		if c.Args().First() == "schema:print" {
+			return nil
+		}
+
+		log.Infoln("Prepare start service")
+
+		var err error
+		driver, err = config.Neo4J().Driver()
+		if err != nil {
+			log.WithError(err).Fatalln("Failed create neo4j driver")
+		}
+
+		migration, err = console.Migration(driver)
+		if err != nil {
+			log.WithError(err).Fatalln("Failed run migration")
+		}
+
+		regionRepo = region.NewRepository(driver)
+		countryRepo = country.NewRepository(driver)
+
+		return nil
+	}
+
 	clientApp.Action = func(c *cli.Context) error {
 		webServer := &graceful.Server{
 			Timeout: 0,
@@ -83,6 +108,11 @@ func main() {
 					os.Exit(1)
 				}
 
+				if err := console.SyncFullTextIndex(driver); err != nil {
+					logrus.WithError(err).Errorln("Failed sync full-text index of the levels")
+					os.Exit(1)
+				}
+
 				logrus.Infoln("Success run database migration to latest")
 
 				return nil
@@ -108,6 +138,145 @@ func main() {
 			Action: func(c *cli.Context) error {
 				return console.CreateMigrationFiles(c.Args().Get(0))
 			},
+		}, {
+			Name:        "location:import",
+			Description: "Import centroids from the CSV file with the header level,code,latitude,longitude",
+			Action: func(c *cli.Context) error {
+				file, err := os.Open(c.Args().Get(0))
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed open location file")
+					return err
+				}
+				defer file.Close()
+
+				rows, err := console.ReadLocations(file)
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed read location file")
+					return err
+				}
+
+				total, err := console.ImportLocations(driver, rows)
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed import locations")
+					return err
+				}
+
+				logrus.Infoln(fmt.Sprintf("Success import %d of %d locations", total, len(rows)))
+
+				return nil
+			},
+		}, {
+			Name:        "boundary:import",
+			Description: "Import boundaries of the level from the GeoJSON file, the feature property of the code is code by default",
+			ArgsUsage:   "<level> <file> [code property]",
+			Action: func(c *cli.Context) error {
+				file, err := os.Open(c.Args().Get(1))
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed open boundary file")
+					return err
+				}
+				defer file.Close()
+
+				property := c.Args().Get(2)
+				if len(property) == 0 {
+					property = console.DefaultCodeProperty
+				}
+
+				rows, err := console.ReadBoundaries(file, property)
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed read boundary file")
+					return err
+				}
+
+				total, err := console.ImportBoundaries(driver, c.Args().Get(0), rows)
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed import boundaries")
+					return err
+				}
+
+				logrus.Infoln(fmt.Sprintf("Success import %d of %d boundaries", total, len(rows)))
+
+				return nil
+			},
+		}, {
+			Name:        "border:import",
+			Description: "Import neighbours of the level from the CSV file with the header code,neighbour",
+			ArgsUsage:   "<level> <file>",
+			Action: func(c *cli.Context) error {
+				file, err := os.Open(c.Args().Get(1))
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed open border file")
+					return err
+				}
+				defer file.Close()
+
+				rows, err := console.ReadBorders(file)
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed read border file")
+					return err
+				}
+
+				total, err := console.ImportBorders(driver, c.Args().Get(0), rows)
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed import borders")
+					return err
+				}
+
+				logrus.Infoln(fmt.Sprintf("Success import %d of %d borders", total, len(rows)))
+
+				return nil
+			},
+		}, {
+			Name:        "border:derive",
+			Description: "Import neighbours of the level derived from the adjacent boundaries of the GeoJSON file",
+			ArgsUsage:   "<level> <file> [code property]",
+			Action: func(c *cli.Context) error {
+				file, err := os.Open(c.Args().Get(1))
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed open boundary file")
+					return err
+				}
+				defer file.Close()
+
+				property := c.Args().Get(2)
+				if len(property) == 0 {
+					property = console.DefaultCodeProperty
+				}
+
+				boundaries, err := console.ReadBoundaries(file, property)
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed read boundary file")
+					return err
+				}
+
+				rows, err := console.DeriveBorders(boundaries, console.DefaultBorderTolerance)
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed derive borders")
+					return err
+				}
+
+				total, err := console.ImportBorders(driver, c.Args().Get(0), rows)
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed import borders")
+					return err
+				}
+
+				logrus.Infoln(fmt.Sprintf("Success import %d of %d borders", total, len(rows)))
+
+				return nil
+			},
+		}, {
+			Name:        "schema:print",
+			Description: "Print the unified GraphQL schema SDL of countries and regions to stdout or to the file",
+			Action: func(c *cli.Context) error {
+				schema, err := web.BuildSchema(nil, nil)
+				if err != nil {
+					logrus.WithError(err).Errorln("Failed create graph schema")
+					return err
+				}
+
+				return console.PrintSchemaFile(schema, c.Args().Get(0))
+			},
 		},
 	}
 
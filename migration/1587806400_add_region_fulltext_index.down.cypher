@@ -0,0 +1 @@
+CALL db.index.fulltext.drop("region_name_fulltext");
\ No newline at end of file
@@ -0,0 +1 @@
+CALL db.index.fulltext.createNodeIndex("region_name_fulltext", ["Province", "City", "Regency", "District", "Village"], ["name"]);
\ No newline at end of file
@@ -0,0 +1,6 @@
+DROP INDEX country_location_idx;
+DROP INDEX province_location_idx;
+DROP INDEX city_location_idx;
+DROP INDEX regency_location_idx;
+DROP INDEX district_location_idx;
+DROP INDEX village_location_idx;
\ No newline at end of file
@@ -0,0 +1,6 @@
+CREATE INDEX country_location_idx FOR (node:Country) ON (node.location);
+CREATE INDEX province_location_idx FOR (node:Province) ON (node.location);
+CREATE INDEX city_location_idx FOR (node:City) ON (node.location);
+CREATE INDEX regency_location_idx FOR (node:Regency) ON (node.location);
+CREATE INDEX district_location_idx FOR (node:District) ON (node.location);
+CREATE INDEX village_location_idx FOR (node:Village) ON (node.location);
\ No newline at end of file
@@ -0,0 +1,6 @@
+MATCH (node:Country) REMOVE node.location;
+MATCH (node:Province) REMOVE node.location;
+MATCH (node:City) REMOVE node.location;
+MATCH (node:Regency) REMOVE node.location;
+MATCH (node:District) REMOVE node.location;
+MATCH (node:Village) REMOVE node.location;
\ No newline at end of file
@@ -0,0 +1,5 @@
+MATCH (node:Country{ISO3166Alpha2: "ID"}) SET node.location = point({latitude: -2.548926, longitude: 118.014863});
+
+UNWIND [{code:"11", latitude:4.695135, longitude:96.749399}, {code:"12", latitude:2.115355, longitude:99.545097}, {code:"13", latitude:-0.739940, longitude:100.800005}, {code:"14", latitude:0.293347, longitude:101.706829}, {code:"15", latitude:-1.610123, longitude:103.613120}, {code:"16", latitude:-3.319437, longitude:104.914565}, {code:"17", latitude:-3.792845, longitude:102.260764}, {code:"18", latitude:-4.558585, longitude:105.406808}, {code:"19", latitude:-2.741051, longitude:106.440587}, {code:"21", latitude:3.945651, longitude:108.142867}, {code:"31", latitude:-6.208763, longitude:106.845599}, {code:"32", latitude:-7.090911, longitude:107.668887}, {code:"33", latitude:-7.150975, longitude:110.140259}, {code:"34", latitude:-7.875385, longitude:110.426209}, {code:"35", latitude:-7.536064, longitude:112.238402}, {code:"36", latitude:-6.405817, longitude:106.064018}, {code:"51", latitude:-8.409518, longitude:115.188916}, {code:"52", latitude:-8.652933, longitude:117.361648}, {code:"53", latitude:-8.657382, longitude:121.079370}, {code:"61", latitude:-0.278781, longitude:111.475285}, {code:"62", latitude:-1.681488, longitude:113.382355}, {code:"63", latitude:-3.092642, longitude:115.283758}, {code:"64", latitude:0.538659, longitude:116.419389}, {code:"65", latitude:3.073093, longitude:116.041389}, {code:"71", latitude:0.624693, longitude:123.975002}, {code:"72", latitude:-1.430025, longitude:121.445618}, {code:"73", latitude:-3.668799, longitude:119.974053}, {code:"74", latitude:-4.144910, longitude:122.174605}, {code:"75", latitude:0.699937, longitude:122.446724}, {code:"76", latitude:-2.844137, longitude:119.232078}, {code:"81", latitude:-3.238462, longitude:130.145273}, {code:"82", latitude:1.570999, longitude:127.808769}, {code:"91", latitude:-4.269928, longitude:138.080353}, {code:"92", latitude:-1.336115, longitude:133.174716}] AS row
+MATCH (node:Province{code: row.code})
+SET node.location = point({latitude: row.latitude, longitude: row.longitude});
\ No newline at end of file
@@ -0,0 +1 @@
+MATCH (:Country{ISO3166Alpha2: "ID"})-[border:BORDERS]-(neighbour:Country) WHERE neighbour.ISO3166Alpha2 IN ["MY", "PG", "TL"] DELETE border;
\ No newline at end of file
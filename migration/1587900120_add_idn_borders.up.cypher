@@ -0,0 +1 @@
+MATCH (country:Country{ISO3166Alpha2: "ID"}), (neighbour:Country) WHERE neighbour.ISO3166Alpha2 IN ["MY", "PG", "TL"] MERGE (country)-[:BORDERS]-(neighbour);
\ No newline at end of file
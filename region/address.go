@@ -0,0 +1,273 @@
+package region
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/dynastymasra/cartographer/domain"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+
+	"github.com/dynastymasra/cookbook"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// MaxAddressLength is the longest address text parsed in characters
+	MaxAddressLength = 256
+	// MaxAddressCandidates is the maximum limit of the parsed candidates
+	MaxAddressCandidates = 20
+
+	// maxAddressPhrases limits the searches of one address
+	maxAddressPhrases = 24
+	// minPhraseLength drops short words of the address, e.g. "no" and "rt", they match too many names as prefix
+	minPhraseLength = 3
+	// maxPhraseWords is the longest administrative division name searched from an address without separator
+	maxPhraseWords = 3
+	// addressHits is the number of matches of each phrase
+	addressHits = 10
+
+	// fuzzyScore is the score of the phrase matched with a different normalized name
+	fuzzyScore = 0.75
+)
+
+var addressSeparator = regexp.MustCompile(`[,;\n/|]+`)
+
+// AddressParser matches the parts of a free-text address to the administrative divisions
+type AddressParser struct {
+	repo Repository
+}
+
+func NewAddressParser(repo Repository) *AddressParser {
+	return &AddressParser{repo: repo}
+}
+
+// Parse returns the administrative division chains matched by the address ordered by confidence,
+// the chain of each match is built from the lowest level up and scored by the address parts it contains
+func (a *AddressParser) Parse(ctx context.Context, text string, limit int) ([]*domain.AddressCandidate, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(a.Parse).Pointer()).Name(),
+	})
+
+	phrases := AddressPhrases(text)
+
+	searches := make([]*domain.Search, 0, len(phrases))
+	for _, phrase := range phrases {
+		search := &domain.Search{
+			Text:  phrase.Text,
+			Limit: addressHits,
+		}
+		if len(phrase.Level) > 0 {
+			search.Levels = []string{phrase.Level}
+		}
+		searches = append(searches, search)
+	}
+
+	results, err := a.repo.SearchAll(ctx, searches)
+	if err != nil {
+		log.WithError(err).Errorln("Failed search address phrases from storage")
+		return nil, err
+	}
+
+	hits := make(map[string][]string)
+	var matched int
+	for i, phrase := range phrases {
+		phrase.Hits = results[i]
+		if len(phrase.Hits) > 0 {
+			matched++
+		}
+		for _, hit := range phrase.Hits {
+			hits[hit.Level] = append(hits[hit.Level], hit.ID)
+		}
+	}
+
+	if matched < 1 {
+		return nil, nil
+	}
+
+	var candidates []*domain.AddressCandidate
+	for _, level := range domain.RegionNodes {
+		if len(hits[level]) < 1 {
+			continue
+		}
+
+		query := provider.NewQuery(level)
+		for _, id := range hits[level] {
+			query.Filter("id", provider.In, id)
+		}
+
+		results, err := a.repo.Ancestors(ctx, query)
+		if err != nil {
+			log.WithField("query", cookbook.Stringify(query)).WithError(err).Errorln("Failed find ancestors from storage")
+			return nil, err
+		}
+
+		for _, ancestors := range results {
+			candidates = append(candidates, candidate(level, ancestors, phrases, matched))
+		}
+	}
+
+	return rank(candidates, limit), nil
+}
+
+// AddressPhrases splits the address by the separators to normalized names, address without separator is
+// split to every sequence of words up to the longest name. Numbers and short parts are dropped.
+func AddressPhrases(text string) []*domain.AddressPhrase {
+	segments := addressSeparator.Split(text, -1)
+
+	var names []domain.Name
+	if len(segments) > 1 {
+		for _, segment := range segments {
+			names = append(names, domain.ParseName(segment))
+		}
+	} else {
+		words := strings.Fields(withoutNumbers(fold(text)))
+		for size := maxPhraseWords; size > 0; size-- {
+			for i := 0; i+size <= len(words); i++ {
+				names = append(names, domain.ParseName(strings.Join(words[i:i+size], " ")))
+			}
+		}
+	}
+
+	seen := make(map[domain.Name]bool)
+	phrases := make([]*domain.AddressPhrase, 0, len(names))
+	for _, name := range names {
+		name.Text = withoutNumbers(name.Text)
+		if len([]rune(name.Text)) < minPhraseLength || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		phrases = append(phrases, &domain.AddressPhrase{Name: name})
+		if len(phrases) >= maxAddressPhrases {
+			break
+		}
+	}
+
+	return phrases
+}
+
+// candidate scores the chain of the matched region, each phrase counts once for the best matched region of the
+// chain, confidence is the score divided by the longer of the chain and the matched phrases,
+// so phrases matched outside the chain or levels of the chain missing from the address lower the confidence
+func candidate(level string, ancestors *domain.Ancestors, phrases []*domain.AddressPhrase, matched int) *domain.AddressCandidate {
+	var lowest *domain.Region
+	for _, phrase := range phrases {
+		for _, hit := range phrase.Hits {
+			if hit.ID == ancestors.ID && hit.Level == level {
+				lowest = hit
+			}
+		}
+	}
+
+	chain := make([]*domain.Region, 0, len(ancestors.Path)+1)
+	chain = append(chain, ancestors.Path...)
+	chain = append(chain, &domain.Region{
+		ID:        lowest.ID,
+		Name:      lowest.Name,
+		Code:      lowest.Code,
+		Location:  lowest.Location,
+		Level:     level,
+		CreatedAt: lowest.CreatedAt,
+		UpdatedAt: lowest.UpdatedAt,
+	})
+
+	ids := make(map[string]*domain.Region, len(chain))
+	for i, region := range chain {
+		ids[region.ID] = region
+		region.Ancestors = &domain.Ancestors{
+			ID:      region.ID,
+			Country: ancestors.Country,
+			Path:    chain[:i],
+		}
+	}
+
+	var score float64
+	for _, phrase := range phrases {
+		var best float64
+		for _, hit := range phrase.Hits {
+			region, ok := ids[hit.ID]
+			if !ok || region.Level != hit.Level {
+				continue
+			}
+
+			// Phrase in the old spelling matches the stored name in the current spelling too
+			if normalized := domain.NormalizeName(region.Name); normalized == phrase.Text || normalized == phrase.Modern().Text {
+				best = 1
+				break
+			}
+			best = fuzzyScore
+		}
+		score += best
+	}
+
+	total := len(chain)
+	if matched > total {
+		total = matched
+	}
+
+	return &domain.AddressCandidate{
+		Confidence: score / float64(total),
+		Country:    ancestors.Country,
+		Regions:    chain,
+	}
+}
+
+// rank orders the candidates by confidence then by the lower level,
+// candidate which is the upper part of a better candidate is dropped
+func rank(candidates []*domain.AddressCandidate, limit int) []*domain.AddressCandidate {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Confidence != candidates[j].Confidence {
+			return candidates[i].Confidence > candidates[j].Confidence
+		}
+		return len(candidates[i].Regions) > len(candidates[j].Regions)
+	})
+
+	var results []*domain.AddressCandidate
+	for _, candidate := range candidates {
+		if contained(results, candidate.Lowest().ID) {
+			continue
+		}
+
+		results = append(results, candidate)
+		if limit > 0 && len(results) >= limit {
+			break
+		}
+	}
+
+	return results
+}
+
+func contained(candidates []*domain.AddressCandidate, id string) bool {
+	for _, candidate := range candidates {
+		for _, region := range candidate.Regions {
+			if region.ID == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fold returns the lower case words of the text separated by space
+func fold(text string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// withoutNumbers drops the number only words, e.g. postal code, house number and RT/RW
+func withoutNumbers(text string) string {
+	var words []string
+	for _, word := range strings.Fields(text) {
+		if strings.IndexFunc(word, func(r rune) bool { return !unicode.IsDigit(r) }) >= 0 {
+			words = append(words, word)
+		}
+	}
+	return strings.Join(words, " ")
+}
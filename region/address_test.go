@@ -0,0 +1,129 @@
+package region_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/config"
+	"github.com/dynastymasra/cartographer/domain"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+	"github.com/dynastymasra/cartographer/region"
+	"github.com/dynastymasra/cartographer/region/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AddressSuite struct {
+	suite.Suite
+	repo *test.MockRepository
+}
+
+func Test_AddressSuite(t *testing.T) {
+	suite.Run(t, new(AddressSuite))
+}
+
+func (a *AddressSuite) SetupSuite() {
+	config.SetupTestLogger()
+}
+
+func (a *AddressSuite) SetupTest() {
+	a.repo = &test.MockRepository{}
+}
+
+func (a *AddressSuite) Test_AddressPhrases_Separator() {
+	phrases := region.AddressPhrases("Jl. Ir. H. Juanda No. 10, Kel. Dago, Kec. Coblong, Kota Bandung, Jawa Barat 40135")
+
+	var names []domain.Name
+	for _, phrase := range phrases {
+		names = append(names, phrase.Name)
+	}
+
+	assert.Equal(a.T(), []domain.Name{
+		{Text: "jl ir h juanda no"},
+		{Level: domain.VillageNode, Text: "dago"},
+		{Level: domain.DistrictNode, Text: "coblong"},
+		{Level: domain.CityNode, Text: "bandung"},
+		{Text: "jawa barat"},
+	}, names)
+}
+
+func (a *AddressSuite) Test_AddressPhrases_Words() {
+	phrases := region.AddressPhrases("Coblong Bandung 40135")
+
+	var names []string
+	for _, phrase := range phrases {
+		names = append(names, phrase.Text)
+	}
+
+	assert.Equal(a.T(), []string{"coblong bandung", "coblong", "bandung"}, names)
+}
+
+func (a *AddressSuite) Test_AddressPhrases_Empty() {
+	assert.Empty(a.T(), region.AddressPhrases("40135, RT 01"))
+}
+
+func (a *AddressSuite) Test_Parse_Success() {
+	ctx := context.Background()
+
+	country := &domain.Country{ID: "a1f3c8f4-2d44-4d7c-9a8e-0b64a5f1b0a1", Name: "Indonesia"}
+	province := &domain.Region{ID: "0c6f3c77-1b2e-4f39-9f5a-6a0b5a0e1c01", Name: "Jawa Barat", Code: "32", Level: domain.ProvinceNode}
+	city := &domain.Region{ID: "0c6f3c77-1b2e-4f39-9f5a-6a0b5a0e1c02", Name: "Bandung", Code: "32.73", Level: domain.CityNode}
+	regency := &domain.Region{ID: "0c6f3c77-1b2e-4f39-9f5a-6a0b5a0e1c03", Name: "Bandung", Code: "32.04", Level: domain.RegencyNode}
+	district := &domain.Region{ID: "0c6f3c77-1b2e-4f39-9f5a-6a0b5a0e1c04", Name: "Coblong", Code: "32.73.02", Level: domain.DistrictNode}
+
+	a.repo.On("SearchAll", ctx, []*domain.Search{
+		{Text: "coblong", Levels: []string{domain.DistrictNode}, Limit: 10},
+		{Text: "bandung", Limit: 10},
+		{Text: "jawa barat", Limit: 10},
+	}).Return([][]*domain.Region{{district}, {city, regency}, {province}}, nil)
+
+	a.repo.On("Ancestors", ctx, provider.NewQuery(domain.ProvinceNode).Filter("id", provider.In, province.ID)).
+		Return([]*domain.Ancestors{{ID: province.ID, Country: country}}, nil)
+	a.repo.On("Ancestors", ctx, provider.NewQuery(domain.CityNode).Filter("id", provider.In, city.ID)).
+		Return([]*domain.Ancestors{{ID: city.ID, Country: country, Path: []*domain.Region{province}}}, nil)
+	a.repo.On("Ancestors", ctx, provider.NewQuery(domain.RegencyNode).Filter("id", provider.In, regency.ID)).
+		Return([]*domain.Ancestors{{ID: regency.ID, Country: country, Path: []*domain.Region{province}}}, nil)
+	a.repo.On("Ancestors", ctx, provider.NewQuery(domain.DistrictNode).Filter("id", provider.In, district.ID)).
+		Return([]*domain.Ancestors{{ID: district.ID, Country: country, Path: []*domain.Region{province, city}}}, nil)
+
+	parser := region.NewAddressParser(a.repo)
+	res, err := parser.Parse(ctx, "Kec. Coblong, Bandung, Jawa Barat", 5)
+
+	assert.NoError(a.T(), err)
+	assert.Len(a.T(), res, 2)
+	assert.Equal(a.T(), float64(1), res[0].Confidence)
+	a.repo.AssertNumberOfCalls(a.T(), "SearchAll", 1)
+	assert.Equal(a.T(), "32.73.02", res[0].Region(domain.DistrictNode).Code)
+	assert.Equal(a.T(), "32.73", res[0].Region(domain.CityNode).Code)
+	assert.Equal(a.T(), country, res[0].Country)
+	assert.Len(a.T(), res[0].Region(domain.DistrictNode).Ancestors.Path, 2)
+	assert.Equal(a.T(), "32.04", res[1].Lowest().Code)
+	assert.True(a.T(), res[1].Confidence < res[0].Confidence)
+}
+
+func (a *AddressSuite) Test_Parse_NotMatched() {
+	ctx := context.Background()
+
+	a.repo.On("SearchAll", ctx, mock.Anything).Return([][]*domain.Region{nil}, nil)
+
+	parser := region.NewAddressParser(a.repo)
+	res, err := parser.Parse(ctx, "Atlantis", 5)
+
+	assert.NoError(a.T(), err)
+	assert.Empty(a.T(), res)
+	a.repo.AssertNotCalled(a.T(), "Ancestors", mock.Anything, mock.Anything)
+}
+
+func (a *AddressSuite) Test_Parse_Error() {
+	ctx := context.Background()
+
+	a.repo.On("SearchAll", ctx, mock.Anything).Return(([][]*domain.Region)(nil), assert.AnError)
+
+	parser := region.NewAddressParser(a.repo)
+	res, err := parser.Parse(ctx, "Coblong", 5)
+
+	assert.Nil(a.T(), res)
+	assert.Error(a.T(), err)
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strconv"
+
+	"github.com/dynastymasra/cartographer/config"
+	"github.com/dynastymasra/cartographer/domain"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+	"github.com/dynastymasra/cartographer/region"
+
+	"github.com/dynastymasra/cookbook"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+)
+
+const ContentTypeGeoJSON = "application/geo+json"
+
+type (
+	featureCollection struct {
+		Type     string     `json:"type"`
+		Features []*feature `json:"features"`
+	}
+
+	feature struct {
+		Type       string           `json:"type"`
+		ID         string           `json:"id"`
+		Properties *domain.Boundary `json:"properties"`
+		Geometry   *domain.Geometry `json:"geometry"`
+	}
+)
+
+// ExportGeometry writes the boundaries of the level as GeoJSON feature collection, the level is the lower case
+// node label of the path, e.g. /v1/geojson/district?parent=32.73&simplify=0.001, parent filters the code prefix
+// and code filters the exact codes, nodes without boundary are not written. The boundaries are paginated by
+// limit and offset ordered by code, limit is capped by config.MaxLimit
+func ExportGeometry(repo region.Repository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestID := fmt.Sprint(r.Context().Value(cookbook.RequestID))
+
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: requestID,
+			"package":          runtime.FuncForPC(reflect.ValueOf(ExportGeometry).Pointer()).Name(),
+		})
+
+		node := domain.Incoming[mux.Vars(r)["level"]]
+		if !isRegionNode(node) {
+			w.Header().Set("Content-Type", "application/json")
+			config.ParseToJSON(config.NewError(http.StatusNotFound, "level", "unknown administrative division level"), w, requestID)
+			return
+		}
+
+		var tolerance float64
+		if simplify := r.URL.Query().Get("simplify"); len(simplify) > 0 {
+			value, err := strconv.ParseFloat(simplify, 64)
+			if err != nil || value < 0 {
+				w.Header().Set("Content-Type", "application/json")
+				config.ParseToJSON(config.NewError(http.StatusBadRequest, "simplify", "simplify must be a positive number"), w, requestID)
+				return
+			}
+			tolerance = value
+		}
+
+		limit, offset := config.Limit, 0
+		if value := r.URL.Query().Get("limit"); len(value) > 0 {
+			number, err := strconv.Atoi(value)
+			if err != nil || number < 1 || number > config.MaxLimit {
+				w.Header().Set("Content-Type", "application/json")
+				config.ParseToJSON(config.NewError(http.StatusBadRequest, "limit",
+					fmt.Sprintf("limit must be between 1 and %d", config.MaxLimit)), w, requestID)
+				return
+			}
+			limit = number
+		}
+		if value := r.URL.Query().Get("offset"); len(value) > 0 {
+			number, err := strconv.Atoi(value)
+			if err != nil || number < 0 {
+				w.Header().Set("Content-Type", "application/json")
+				config.ParseToJSON(config.NewError(http.StatusBadRequest, "offset", "offset must be a positive number"), w, requestID)
+				return
+			}
+			offset = number
+		}
+
+		query := provider.NewQuery(node).Ordering("code", provider.Ascending).Slice(offset, limit)
+		if parent := r.URL.Query().Get("parent"); len(parent) > 0 {
+			query.Filter("code", provider.StartsWith, parent+".")
+		}
+		for _, code := range r.URL.Query()["code"] {
+			query.Filter("code", provider.In, code)
+		}
+
+		boundaries, err := repo.Boundaries(r.Context(), query)
+		if err != nil {
+			log.WithField("query", cookbook.Stringify(query)).WithError(err).Errorln("Failed find boundaries from storage")
+			w.Header().Set("Content-Type", "application/json")
+			config.ParseToJSON(config.NewError(http.StatusInternalServerError, "", err.Error()), w, requestID)
+			return
+		}
+
+		collection := &featureCollection{
+			Type:     "FeatureCollection",
+			Features: make([]*feature, 0, len(boundaries)),
+		}
+		for _, boundary := range boundaries {
+			geometry, err := domain.ParseGeometry([]byte(boundary.Geometry))
+			if err != nil {
+				log.WithField("code", boundary.Code).WithError(err).Warnln("Failed parse boundary geometry")
+				continue
+			}
+
+			collection.Features = append(collection.Features, &feature{
+				Type: "Feature",
+				ID:   boundary.Code,
+				Properties: &domain.Boundary{
+					ID:    boundary.ID,
+					Code:  boundary.Code,
+					Name:  boundary.Name,
+					Level: boundary.Level,
+				},
+				Geometry: geometry.Simplify(tolerance),
+			})
+		}
+
+		w.Header().Set("Content-Type", ContentTypeGeoJSON)
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(collection); err != nil {
+			log.WithError(err).Errorln("Failed write GeoJSON response")
+		}
+	}
+}
+
+func isRegionNode(node string) bool {
+	for _, region := range domain.RegionNodes {
+		if region == node {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/config"
+	"github.com/dynastymasra/cartographer/domain"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+	"github.com/dynastymasra/cartographer/region"
+	"github.com/dynastymasra/cartographer/region/handler"
+	"github.com/dynastymasra/cartographer/region/test"
+
+	"github.com/dynastymasra/cookbook"
+	"github.com/gorilla/mux"
+	"github.com/graphql-go/graphql"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	graph "github.com/graphql-go/handler"
+)
+
+const coblong = `{"type":"Polygon","coordinates":[[[107.6,-6.9],[107.65,-6.9001],[107.7,-6.9],[107.7,-6.8],[107.6,-6.9]]]}`
+
+type GeometrySuite struct {
+	suite.Suite
+	repo *test.MockRepository
+}
+
+func Test_GeometrySuite(t *testing.T) {
+	suite.Run(t, new(GeometrySuite))
+}
+
+func (g *GeometrySuite) SetupSuite() {
+	config.SetupTestLogger()
+}
+
+func (g *GeometrySuite) SetupTest() {
+	g.repo = &test.MockRepository{}
+}
+
+func (g *GeometrySuite) Test_Geometry_Simplify() {
+	body := []byte(`{"query":"{district(id: \"e81f509f-38ec-42e8-9a1c-8e527977e526\") {name geometry(simplify: 0.001)}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+	ctx = domain.WithRegionLoader(ctx, region.NewLoader(g.repo))
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(g.repo),
+	})
+	if err != nil {
+		g.T().Fatal(err)
+	}
+
+	query := provider.NewQuery(domain.DistrictNode)
+	query.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	query.Select("name")
+
+	g.repo.On("Find", ctx, query).Return(&domain.Region{ID: "e81f509f-38ec-42e8-9a1c-8e527977e526", Name: "Coblong"}, nil)
+	g.repo.On("Boundaries", ctx, provider.NewQuery(domain.DistrictNode).Filter("id", provider.In, "e81f509f-38ec-42e8-9a1c-8e527977e526")).
+		Return([]*domain.Boundary{{ID: "e81f509f-38ec-42e8-9a1c-8e527977e526", Code: "32.73.02", Geometry: coblong}}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(g.T(), http.StatusOK, w.Code)
+	assert.Contains(g.T(), w.Body.String(), `"geometry":{"coordinates":[[[107.6,-6.9],[107.7,-6.9],[107.7,-6.8],[107.6,-6.9]]],"type":"Polygon"}`)
+}
+
+func (g *GeometrySuite) Test_ExportGeometry_Success() {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/geojson/district?parent=32.73", nil)
+	req = mux.SetURLVars(req, map[string]string{"level": "district"})
+
+	query := provider.NewQuery(domain.DistrictNode).
+		Ordering("code", provider.Ascending).
+		Slice(0, config.Limit).
+		Filter("code", provider.StartsWith, "32.73.")
+
+	g.repo.On("Boundaries", req.Context(), query).Return([]*domain.Boundary{
+		{ID: "e81f509f-38ec-42e8-9a1c-8e527977e526", Code: "32.73.02", Name: "Coblong", Level: domain.DistrictNode, Geometry: coblong},
+	}, nil)
+
+	handler.ExportGeometry(g.repo)(w, req)
+
+	assert.Equal(g.T(), http.StatusOK, w.Code)
+	assert.Equal(g.T(), handler.ContentTypeGeoJSON, w.Header().Get("Content-Type"))
+	assert.JSONEq(g.T(), `{"type":"FeatureCollection","features":[{"type":"Feature","id":"32.73.02",
+		"properties":{"id":"e81f509f-38ec-42e8-9a1c-8e527977e526","code":"32.73.02","name":"Coblong","level":"District"},
+		"geometry":{"type":"Polygon","coordinates":[[[107.6,-6.9],[107.65,-6.9001],[107.7,-6.9],[107.7,-6.8],[107.6,-6.9]]]}}]}`, w.Body.String())
+}
+
+func (g *GeometrySuite) Test_ExportGeometry_Page() {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/geojson/village?limit=50&offset=100", nil)
+	req = mux.SetURLVars(req, map[string]string{"level": "village"})
+
+	query := provider.NewQuery(domain.VillageNode).Ordering("code", provider.Ascending).Slice(100, 50)
+	g.repo.On("Boundaries", req.Context(), query).Return([]*domain.Boundary{}, nil)
+
+	handler.ExportGeometry(g.repo)(w, req)
+
+	assert.Equal(g.T(), http.StatusOK, w.Code)
+	assert.JSONEq(g.T(), `{"type":"FeatureCollection","features":[]}`, w.Body.String())
+}
+
+func (g *GeometrySuite) Test_ExportGeometry_InvalidLimit() {
+	for _, path := range []string{"/v1/geojson/village?limit=0", "/v1/geojson/village?limit=101", "/v1/geojson/village?offset=-1"} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req = mux.SetURLVars(req, map[string]string{"level": "village"})
+
+		handler.ExportGeometry(g.repo)(w, req)
+
+		assert.Equal(g.T(), http.StatusBadRequest, w.Code, path)
+	}
+	g.repo.AssertNotCalled(g.T(), "Boundaries", mock.Anything, mock.Anything)
+}
+
+func (g *GeometrySuite) Test_ExportGeometry_UnknownLevel() {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/geojson/country", nil)
+	req = mux.SetURLVars(req, map[string]string{"level": "country"})
+
+	handler.ExportGeometry(g.repo)(w, req)
+
+	assert.Equal(g.T(), http.StatusNotFound, w.Code)
+}
+
+func (g *GeometrySuite) Test_ExportGeometry_InvalidSimplify() {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/geojson/province?simplify=-1", nil)
+	req = mux.SetURLVars(req, map[string]string{"level": "province"})
+
+	handler.ExportGeometry(g.repo)(w, req)
+
+	assert.Equal(g.T(), http.StatusBadRequest, w.Code)
+}
+
+func (g *GeometrySuite) Test_ExportGeometry_Error() {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/geojson/province", nil)
+	req = mux.SetURLVars(req, map[string]string{"level": "province"})
+
+	g.repo.On("Boundaries", req.Context(), provider.NewQuery(domain.ProvinceNode).Ordering("code", provider.Ascending).Slice(0, config.Limit)).
+		Return(([]*domain.Boundary)(nil), assert.AnError)
+
+	handler.ExportGeometry(g.repo)(w, req)
+
+	assert.Equal(g.T(), http.StatusInternalServerError, w.Code)
+}
@@ -1,79 +1,29 @@
 package handler
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
 
 	"github.com/dynastymasra/cartographer/config"
 	"github.com/dynastymasra/cartographer/domain"
 	"github.com/dynastymasra/cartographer/infrastructure/provider"
+	webHandler "github.com/dynastymasra/cartographer/infrastructure/web/handler"
 	"github.com/dynastymasra/cartographer/region"
 
-	"github.com/graphql-go/graphql/gqlerrors"
-
 	"github.com/sirupsen/logrus"
 
 	"github.com/dynastymasra/cookbook"
 	"github.com/graphql-go/graphql"
-	graph "github.com/graphql-go/handler"
 )
 
+// FindRegion is kept for the old endpoint, it serves the same as the unified GraphQL endpoint
 func FindRegion(schema graphql.Schema) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-
-		requestID := r.Context().Value(cookbook.RequestID).(string)
-		log := logrus.WithFields(logrus.Fields{
-			cookbook.RequestID: requestID,
-			"package":          runtime.FuncForPC(reflect.ValueOf(FindRegion).Pointer()).Name(),
-		})
-
-		req := graph.NewRequestOptions(r)
-
-		result := graphql.Do(graphql.Params{
-			Schema:         schema,
-			RequestString:  req.Query,
-			VariableValues: req.Variables,
-			OperationName:  req.OperationName,
-			Context:        r.Context(),
-		})
-
-		if len(result.Errors) > 0 {
-			var errs []cookbook.JSON
-			for _, err := range result.Errors {
-				log.WithError(err).Warnln("Failed process request")
-
-				switch err := err.OriginalError().(type) {
-				case *gqlerrors.Error:
-					if err, ok := err.OriginalError.(*config.ServiceError); ok {
-						if err.Code() >= http.StatusInternalServerError {
-							log.WithError(err).Errorln("Failed process data from storage")
-						}
-
-						config.ParseToJSON(err, w, requestID)
-						return
-					}
-					errs = append(errs, cookbook.JSON{
-						"message": err.Error(),
-					})
-				default:
-					errs = append(errs, cookbook.JSON{
-						"message": err.Error(),
-					})
-				}
-			}
-
-			w.WriteHeader(http.StatusBadRequest)
-			fmt.Fprint(w, cookbook.FailResponse(&cookbook.JSON{"errors": errs}, requestID).Stringify())
-			return
-		}
-
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, cookbook.SuccessDataResponse(result.Data, nil).Stringify())
-	}
+	return webHandler.GraphQL(schema)
 }
 
 func RegionQuery(repo region.Repository) *graphql.Object {
@@ -81,61 +31,86 @@ func RegionQuery(repo region.Repository) *graphql.Object {
 		graphql.ObjectConfig{
 			Name:        "Query",
 			Description: "Query region data from storage",
-			Fields: graphql.Fields{
-				"province": &graphql.Field{
-					Type:    domain.ProvinceType,
-					Args:    domain.RegionArgs,
-					Resolve: RegionResolver(domain.ProvinceNode, repo),
-				},
-				"provinces": &graphql.Field{
-					Type:    graphql.NewList(domain.ProvinceType),
-					Args:    domain.ListRegionArgs,
-					Resolve: ListRegionResolver(domain.ProvinceNode, repo),
-				},
-				"city": &graphql.Field{
-					Type:    domain.CityType,
-					Args:    domain.RegionArgs,
-					Resolve: RegionResolver(domain.CityNode, repo),
-				},
-				"cities": &graphql.Field{
-					Type:    graphql.NewList(domain.CityType),
-					Args:    domain.ListRegionArgs,
-					Resolve: ListRegionResolver(domain.CityNode, repo),
-				},
-				"regency": &graphql.Field{
-					Type:    domain.RegencyType,
-					Args:    domain.RegionArgs,
-					Resolve: RegionResolver(domain.RegencyNode, repo),
-				},
-				"regencies": &graphql.Field{
-					Type:    graphql.NewList(domain.RegencyType),
-					Args:    domain.ListRegionArgs,
-					Resolve: ListRegionResolver(domain.RegencyNode, repo),
-				},
-				"district": &graphql.Field{
-					Type:    domain.DistrictType,
-					Args:    domain.RegionArgs,
-					Resolve: RegionResolver(domain.DistrictNode, repo),
-				},
-				"districts": &graphql.Field{
-					Type:    graphql.NewList(domain.DistrictType),
-					Args:    domain.ListRegionArgs,
-					Resolve: ListRegionResolver(domain.DistrictNode, repo),
-				},
-				"village": &graphql.Field{
-					Type:    domain.VillageType,
-					Args:    domain.RegionArgs,
-					Resolve: RegionResolver(domain.VillageNode, repo),
-				},
-				"villages": &graphql.Field{
-					Type:    graphql.NewList(domain.VillageType),
-					Args:    domain.ListRegionArgs,
-					Resolve: ListRegionResolver(domain.VillageNode, repo),
-				},
-			},
+			Fields:      RegionFields(repo),
 		})
 }
 
+// RegionFields returns root query fields of region, used by the module query and the unified query
+func RegionFields(repo region.Repository) graphql.Fields {
+	fields := graphql.Fields{
+		"regionCounts": &graphql.Field{
+			Type:        graphql.NewList(domain.RegionCountType),
+			Args:        domain.AggregateRegionArgs,
+			Description: "Count administrative divisions grouped by the upper level",
+			Resolve:     AggregateRegionResolver(repo),
+		},
+		"search": &graphql.Field{
+			Type:        graphql.NewList(domain.AdministrativeDivisionInterface),
+			Args:        domain.SearchRegionArgs,
+			Description: "Search administrative divisions of every level by name, ordered by the closest match",
+			Resolve:     SearchRegionResolver(repo),
+		},
+		"parseAddress": &graphql.Field{
+			Type:        graphql.NewList(domain.AddressCandidateType),
+			Args:        domain.ParseAddressArgs,
+			Description: "Match free-text address to administrative divisions, ordered by confidence",
+			Resolve:     ParseAddressResolver(repo),
+		},
+		"locate": &graphql.Field{
+			Type:        domain.AdministrativeDivisionInterface,
+			Args:        domain.LocateArgs,
+			Description: "Lowest administrative division whose boundary contains the point, null when no boundary contains it",
+			Resolve:     LocateRegionResolver(repo),
+		},
+		"nearby": &graphql.Field{
+			Type:        graphql.NewList(domain.AdministrativeDivisionInterface),
+			Args:        domain.NearbyArgs,
+			Description: "Administrative divisions of the level within the radius of the point, ordered by distance",
+			Resolve:     NearbyRegionResolver(repo),
+		},
+		"nearest": &graphql.Field{
+			Type:        domain.AdministrativeDivisionInterface,
+			Args:        domain.NearestArgs,
+			Description: "Administrative division of the level with the closest location to the point",
+			Resolve:     NearestRegionResolver(repo),
+		},
+		"validateRegions": &graphql.Field{
+			Type:        graphql.NewNonNull(domain.RegionValidationType),
+			Args:        domain.ValidateRegionArgs,
+			Description: "Check the codes exist and each one is a child of the supplied upper level",
+			Resolve:     ValidateRegionResolver(repo),
+		},
+	}
+
+	for _, level := range domain.Levels {
+		node := level.Label
+		object := domain.LevelTypes[node]
+
+		fields[level.Field()] = &graphql.Field{
+			Type:    object,
+			Args:    domain.RegionArgs,
+			Resolve: RegionResolver(node, repo),
+		}
+		fields[level.Plural] = &graphql.Field{
+			Type:    graphql.NewList(object),
+			Args:    domain.LevelListArgs[node],
+			Resolve: ListRegionResolver(node, repo),
+		}
+		fields[level.Plural+"Connection"] = &graphql.Field{
+			Type:    domain.LevelConnectionTypes[node],
+			Args:    domain.LevelConnectionArgs[node],
+			Resolve: ConnectionRegionResolver(node, repo),
+		}
+		fields[level.Plural+"Count"] = &graphql.Field{
+			Type:    graphql.NewNonNull(graphql.Int),
+			Args:    domain.LevelCountArgs[node],
+			Resolve: CountRegionResolver(node, repo),
+		}
+	}
+
+	return fields
+}
+
 func RegionResolver(node string, repo region.Repository) graphql.FieldResolveFn {
 	return func(p graphql.ResolveParams) (interface{}, error) {
 		log := logrus.WithFields(logrus.Fields{
@@ -147,6 +122,16 @@ func RegionResolver(node string, repo region.Repository) graphql.FieldResolveFn
 		query := provider.NewQuery(node)
 
 		for key, field := range p.Args {
+			if key == "name" {
+				id, err := lookupName(p, node, repo, field.(string))
+				if err != nil {
+					return nil, err
+				}
+
+				query.Filter("id", provider.Equal, id)
+				continue
+			}
+
 			query.Filter(key, provider.Equal, field)
 		}
 
@@ -155,6 +140,8 @@ func RegionResolver(node string, repo region.Repository) graphql.FieldResolveFn
 			return nil, config.NewError(http.StatusPreconditionFailed, strings.ToLower(node), "need min one argument")
 		}
 
+		domain.Project(p.Info, query)
+
 		res, err := repo.Find(p.Context, query)
 		if err != nil {
 			if err.Error() == provider.ErrorRecordNotFound {
@@ -165,8 +152,11 @@ func RegionResolver(node string, repo region.Repository) graphql.FieldResolveFn
 				return nil, config.NewError(http.StatusPreconditionFailed, strings.ToLower(node), err.Error())
 			}
 
-			log.WithField("query", cookbook.Stringify(query)).WithError(err).Errorln("Failed find region from storage")
-			return nil, config.NewError(http.StatusInternalServerError, "", err.Error())
+			return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find region from storage")
+		}
+
+		if err := ancestors(p, node, repo, res); err != nil {
+			return nil, err
 		}
 
 		return res, nil
@@ -181,39 +171,515 @@ func ListRegionResolver(node string, repo region.Repository) graphql.FieldResolv
 			"arguments":        cookbook.Stringify(p.Args),
 		})
 
-		limit := p.Args["limit"].(int)
-		offset := p.Args["offset"].(int)
-
-		// Delete value from map avoid duplicate value
-		delete(p.Args, "limit")
-		delete(p.Args, "offset")
-		delete(p.Args, strings.ToLower(node))
+		offset, limit, err := domain.SliceArgs(p.Args)
+		if err != nil {
+			return nil, err
+		}
 
-		query := provider.NewQuery(node)
+		query := listQuery(node, p.Args)
 		query.Slice(offset, limit)
-		query.Ordering("name", provider.Ascending)
+		domain.Project(p.Info, query)
 
-		for key, field := range p.Args {
-			switch val := field.(type) {
-			case map[string]interface{}:
-				if node, ok := domain.Incoming[key]; ok {
-					q := provider.NewQuery(node)
-					for k, v := range val {
-						q.Filter(k, provider.Equal, v)
-					}
-					query.Incoming(q)
-				}
-			default:
-				query.Filter(key, provider.Equal, field)
-			}
+		results, err := repo.FindAll(p.Context, query)
+		if err != nil {
+			return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find region from storage")
+		}
+
+		if err := ancestors(p, node, repo, results...); err != nil {
+			return nil, err
+		}
+
+		return results, nil
+	}
+}
+
+func ConnectionRegionResolver(node string, repo region.Repository) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(ConnectionRegionResolver).Pointer()).Name(),
+			"arguments":        cookbook.Stringify(p.Args),
+		})
+
+		page, err := domain.NewPage(p.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		// Order by id after the ordered fields, cursor must point to an unique position
+		query := listQuery(node, p.Args)
+		query.Ordering("id", provider.Ascending)
+		count := query.Copy()
+
+		domain.Project(p.Info, query, "edges", "node")
+		if err := page.Apply(query); err != nil {
+			return nil, err
 		}
 
 		results, err := repo.FindAll(p.Context, query)
 		if err != nil {
-			log.WithField("query", cookbook.Stringify(query)).WithError(err).Errorln("Failed find region from storage")
+			return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find region from storage")
+		}
+
+		if err := ancestors(p, node, repo, results...); err != nil {
+			return nil, err
+		}
+
+		nodes := make([]interface{}, 0, len(results))
+		for _, result := range results {
+			nodes = append(nodes, result)
+		}
+
+		connection, err := page.Connection(nodes, count.Orderings)
+		if err != nil {
+			log.WithError(err).Errorln("Failed create region connection")
 			return nil, config.NewError(http.StatusInternalServerError, "", err.Error())
 		}
 
+		connection.TotalCount = func(ctx context.Context) (int, error) {
+			total, err := repo.Count(ctx, count)
+			if err != nil {
+				return 0, domain.StorageError(log.WithField("query", cookbook.Stringify(count)), err, "Failed count region from storage")
+			}
+
+			return total, nil
+		}
+
+		return connection, nil
+	}
+}
+
+func CountRegionResolver(node string, repo region.Repository) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(CountRegionResolver).Pointer()).Name(),
+			"arguments":        cookbook.Stringify(p.Args),
+		})
+
+		query := listQuery(node, p.Args)
+
+		total, err := repo.Count(p.Context, query)
+		if err != nil {
+			return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed count region from storage")
+		}
+
+		return total, nil
+	}
+}
+
+func AggregateRegionResolver(repo region.Repository) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(AggregateRegionResolver).Pointer()).Name(),
+			"arguments":        cookbook.Stringify(p.Args),
+		})
+
+		node := p.Args["level"].(string)
+		group := p.Args["groupBy"].(string)
+
+		// Group without path to the counted level would match every node of the graph
+		if group != domain.CountryNode && provider.RelationshipOf(group, node) == nil {
+			return nil, config.NewError(http.StatusPreconditionFailed, "groupBy", "group must be upper level of the counted level")
+		}
+
+		query := listQuery(node, p.Args)
+		query.IncomingOf(group)
+
+		results, err := repo.Aggregate(p.Context, query, group)
+		if err != nil {
+			return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed aggregate region from storage")
+		}
+
+		return results, nil
+	}
+}
+
+func SearchRegionResolver(repo region.Repository) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(SearchRegionResolver).Pointer()).Name(),
+			"arguments":        cookbook.Stringify(p.Args),
+		})
+
+		text := p.Args["text"].(string)
+		name := domain.ParseName(text)
+		if len(name.Text) < 1 {
+			return nil, config.NewError(http.StatusPreconditionFailed, "text", "need min one word")
+		}
+
+		search := &domain.Search{
+			Limit: p.Args["limit"].(int),
+		}
+		if search.Limit < 1 {
+			return nil, config.NewError(http.StatusPreconditionFailed, "limit", "limit must be greater than zero")
+		}
+
+		levels, _ := p.Args["levels"].([]interface{})
+		for _, level := range levels {
+			search.Levels = append(search.Levels, level.(string))
+		}
+
+		if country, ok := p.Args["country"].(map[string]interface{}); ok {
+			search.Country = upperQuery(domain.CountryNode, country)
+		}
+
+		var results []*domain.Region
+		// Text in the old spelling is only searched when the text as written matches nothing
+		for _, text := range spellings(hintedNames(name, text)...) {
+			spelling := *search
+			spelling.Text = text
+
+			res, err := repo.Search(p.Context, &spelling)
+			if err != nil {
+				return nil, domain.StorageError(log, err, "Failed search region from storage")
+			}
+
+			results = res
+			if len(results) > 0 {
+				break
+			}
+		}
+
+		// Prefix of the text is only a hint, the levels aren't narrowed and the level of the prefix comes first,
+		// e.g. "kab bandung" returns the regencies first
+		if len(name.Level) > 0 {
+			sort.SliceStable(results, func(i, j int) bool {
+				return results[i].Level == name.Level && results[j].Level != name.Level
+			})
+		}
+
+		return results, nil
+	}
+}
+
+func ParseAddressResolver(repo region.Repository) graphql.FieldResolveFn {
+	parser := region.NewAddressParser(repo)
+
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(ParseAddressResolver).Pointer()).Name(),
+			"arguments":        cookbook.Stringify(p.Args),
+		})
+
+		text := p.Args["text"].(string)
+		if len([]rune(text)) > region.MaxAddressLength {
+			return nil, config.NewError(http.StatusPreconditionFailed, "text",
+				fmt.Sprintf("text must not exceed %d characters", region.MaxAddressLength))
+		}
+
+		if len(region.AddressPhrases(text)) < 1 {
+			return nil, config.NewError(http.StatusPreconditionFailed, "text", "need min one word")
+		}
+
+		limit := p.Args["limit"].(int)
+		if limit < 1 || limit > region.MaxAddressCandidates {
+			return nil, config.NewError(http.StatusPreconditionFailed, "limit",
+				fmt.Sprintf("limit must be between 1 and %d", region.MaxAddressCandidates))
+		}
+
+		results, err := parser.Parse(p.Context, text, limit)
+		if err != nil {
+			return nil, domain.StorageError(log, err, "Failed parse address")
+		}
+
 		return results, nil
 	}
 }
+
+func LocateRegionResolver(repo region.Repository) graphql.FieldResolveFn {
+	locator := region.NewLocator(repo)
+
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(LocateRegionResolver).Pointer()).Name(),
+			"arguments":        cookbook.Stringify(p.Args),
+		})
+
+		lat, lng := p.Args["lat"].(float64), p.Args["lng"].(float64)
+		if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+			return nil, config.NewError(http.StatusPreconditionFailed, "location", "latitude or longitude out of range")
+		}
+
+		boundary, err := locator.Locate(p.Context, lat, lng)
+		if err != nil {
+			return nil, domain.StorageError(log, err, "Failed locate region")
+		}
+
+		if boundary == nil {
+			return nil, nil
+		}
+
+		query := provider.NewQuery(boundary.Level).Filter("id", provider.Equal, boundary.ID)
+		domain.Project(p.Info, query)
+
+		res, err := repo.Find(p.Context, query)
+		if err != nil {
+			return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find region from storage")
+		}
+		res.Level = boundary.Level
+
+		if err := ancestors(p, boundary.Level, repo, res); err != nil {
+			return nil, err
+		}
+
+		return res, nil
+	}
+}
+
+func NearbyRegionResolver(repo region.Repository) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		radius := p.Args["radiusKm"].(float64)
+		if radius <= 0 {
+			return nil, config.NewError(http.StatusPreconditionFailed, "radiusKm", "radius must be greater than zero")
+		}
+
+		return near(p, repo, radius*1000, p.Args["limit"].(int))
+	}
+}
+
+func NearestRegionResolver(repo region.Repository) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		results, err := near(p, repo, 0, 1)
+		if err != nil || len(results) < 1 {
+			return nil, err
+		}
+
+		return results[0], nil
+	}
+}
+
+func ValidateRegionResolver(repo region.Repository) graphql.FieldResolveFn {
+	validator := region.NewValidator(repo)
+
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(ValidateRegionResolver).Pointer()).Name(),
+			"arguments":        cookbook.Stringify(p.Args),
+		})
+
+		codes := make(map[string]string, len(p.Args))
+		for key, value := range p.Args {
+			if node, ok := domain.Incoming[key]; ok {
+				codes[node] = value.(string)
+			}
+		}
+
+		if len(codes) < 1 {
+			return nil, config.NewError(http.StatusPreconditionFailed, "region", "need min one argument")
+		}
+
+		result, err := validator.Validate(p.Context, codes)
+		if err != nil {
+			return nil, domain.StorageError(log, err, "Failed validate regions")
+		}
+
+		return result, nil
+	}
+}
+
+// near returns the regions of the level argument ordered by the distance to the point argument,
+// radius is in meters and zero means no maximum distance
+func near(p graphql.ResolveParams, repo region.Repository, radius float64, limit int) ([]*domain.Region, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(near).Pointer()).Name(),
+		"arguments":        cookbook.Stringify(p.Args),
+	})
+
+	lat, lng := p.Args["lat"].(float64), p.Args["lng"].(float64)
+	if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return nil, config.NewError(http.StatusPreconditionFailed, "location", "latitude or longitude out of range")
+	}
+
+	if limit < 1 {
+		return nil, config.NewError(http.StatusPreconditionFailed, "limit", "limit must be greater than zero")
+	}
+
+	node := p.Args["level"].(string)
+	query := listQuery(node, p.Args).Near("location", lat, lng, radius).Slice(0, limit)
+	domain.Project(p.Info, query)
+
+	results, err := repo.FindAll(p.Context, query)
+	if err != nil {
+		return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find region from storage")
+	}
+
+	for _, res := range results {
+		res.Level = node
+	}
+
+	if err := ancestors(p, node, repo, results...); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// lookupName returns id of the only node with the same normalized name, prefix of the name is only a hint of the level
+func lookupName(p graphql.ResolveParams, node string, repo region.Repository, text string) (string, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(lookupName).Pointer()).Name(),
+	})
+
+	name := domain.ParseName(text)
+	if len(name.Text) < 1 {
+		return "", config.NewError(http.StatusPreconditionFailed, "name", "need min one word")
+	}
+
+	// Name of the prefix level is looked up without the prefix, e.g. city "Kota Bandung" is "bandung",
+	// other levels only by the whole name, e.g. district "Kota Baru"
+	names := hintedNames(name, text)
+	if len(name.Level) > 0 && name.Level != node {
+		names = names[1:]
+	}
+
+	var ids []string
+	// Name in the old spelling is only looked up when the name as written matches nothing
+	for _, text := range spellings(names...) {
+		search := &domain.Search{
+			Text:   text,
+			Levels: []string{node},
+			Limit:  config.MaxLimit,
+		}
+
+		results, err := repo.Search(p.Context, search)
+		if err != nil {
+			return "", domain.StorageError(log, err, "Failed search region from storage")
+		}
+
+		for _, result := range results {
+			if domain.NormalizeName(result.Name) == text || domain.FoldName(result.Name) == text {
+				ids = append(ids, result.ID)
+			}
+		}
+
+		if len(ids) > 0 {
+			break
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", config.NewError(http.StatusNotFound, strings.ToLower(node), provider.ErrorRecordNotFound)
+	case 1:
+		return ids[0], nil
+	}
+
+	return "", config.NewError(http.StatusPreconditionFailed, strings.ToLower(node), provider.ErrorRecordMoreThanOne)
+}
+
+// spellings returns each normalized name as written followed by the name in the current spelling when it's different
+func spellings(names ...domain.Name) []string {
+	var texts []string
+	for _, name := range names {
+		texts = append(texts, name.Text)
+		if modern := name.Modern(); modern.Text != name.Text {
+			texts = append(texts, modern.Text)
+		}
+	}
+	return texts
+}
+
+// hintedNames returns the name without prefix and the whole name when the name has prefix, the whole name is
+// matched when the name without prefix matches nothing since the prefix can be part of the name
+func hintedNames(name domain.Name, text string) []domain.Name {
+	names := []domain.Name{name}
+	if full := domain.FoldName(text); full != name.Text {
+		names = append(names, domain.Name{Text: full})
+	}
+	return names
+}
+
+// upperQuery filters the upper node by the input, the nested input filters the node related to the upper node,
+// e.g. currency of the country
+func upperQuery(node string, input map[string]interface{}) *provider.Query {
+	query := provider.NewQuery(node)
+	for key, value := range input {
+		nested, ok := value.(map[string]interface{})
+		if !ok {
+			query.Filter(key, provider.Equal, value)
+			continue
+		}
+
+		if label, ok := domain.Nested[key]; ok {
+			out := provider.NewQuery(label)
+			for property, value := range nested {
+				out.Filter(property, provider.Equal, value)
+			}
+			query.Outgoing(out)
+		}
+	}
+
+	return query
+}
+
+// listQuery creates query of the node ordered by the orderBy argument or name from list arguments, pagination arguments are ignored
+func listQuery(node string, args map[string]interface{}) *provider.Query {
+	query := provider.NewQuery(node)
+	domain.Order(query, args["orderBy"])
+
+	for key, field := range args {
+		switch key {
+		case "limit", "offset", "first", "after", "last", "before", "where", "orderBy", "level", "groupBy", "lat", "lng", "radiusKm",
+			strings.ToLower(node):
+			continue
+		}
+
+		switch val := field.(type) {
+		case map[string]interface{}:
+			if node, ok := domain.Incoming[key]; ok {
+				query.Incoming(upperQuery(node, val))
+			}
+		default:
+			query.Filter(key, provider.Equal, field)
+		}
+	}
+
+	where, _ := args["where"].(map[string]interface{})
+	query.Where(where, domain.Incoming, nil)
+
+	return query
+}
+
+// ancestors fills ancestors of the regions with one query, only when the ancestor fields are requested
+func ancestors(p graphql.ResolveParams, node string, repo region.Repository, regions ...*domain.Region) error {
+	if len(regions) < 1 || !domain.Selected(p.Info, domain.AncestorFields...) {
+		return nil
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: p.Context.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(ancestors).Pointer()).Name(),
+	})
+
+	query := provider.NewQuery(node)
+	for _, region := range regions {
+		query.Filter("id", provider.In, region.ID)
+	}
+
+	results, err := repo.Ancestors(p.Context, query)
+	if err != nil {
+		return domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find ancestors from storage")
+	}
+
+	paths := make(map[string]*domain.Ancestors, len(results))
+	for _, result := range results {
+		paths[result.ID] = result
+	}
+
+	for _, region := range regions {
+		region.Ancestors = paths[region.ID]
+		if region.Ancestors == nil {
+			region.Ancestors = &domain.Ancestors{ID: region.ID}
+		}
+	}
+
+	return nil
+}
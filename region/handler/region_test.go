@@ -4,18 +4,22 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/dynastymasra/cartographer/config"
 	"github.com/dynastymasra/cartographer/domain"
 	"github.com/dynastymasra/cartographer/infrastructure/provider"
+	"github.com/dynastymasra/cartographer/region"
 	"github.com/dynastymasra/cartographer/region/handler"
 	"github.com/dynastymasra/cartographer/region/test"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/dynastymasra/cookbook"
 	"github.com/graphql-go/graphql"
@@ -79,6 +83,7 @@ func (r *RegionSuite) Test_FindRegion_NotFound() {
 	query := provider.NewQuery("City")
 	query.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
 
+	query.Select("id", "name", "code", "createdAt", "updatedAt")
 	r.repo.On("Find", ctx, query).Return((*domain.Region)(nil), errors.New(provider.ErrorRecordNotFound))
 
 	handler.FindRegion(schema)(w, req.WithContext(ctx))
@@ -105,6 +110,7 @@ func (r *RegionSuite) Test_FindRegion_MoreThanOne() {
 	query := provider.NewQuery("City")
 	query.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
 
+	query.Select("id", "name", "code", "createdAt", "updatedAt")
 	r.repo.On("Find", ctx, query).Return((*domain.Region)(nil), errors.New(provider.ErrorRecordMoreThanOne))
 
 	handler.FindRegion(schema)(w, req.WithContext(ctx))
@@ -152,6 +158,7 @@ func (r *RegionSuite) Test_FindRegion_Error() {
 	query := provider.NewQuery("City")
 	query.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
 
+	query.Select("id", "name", "code", "createdAt", "updatedAt")
 	r.repo.On("Find", ctx, query).Return((*domain.Region)(nil), assert.AnError)
 
 	handler.FindRegion(schema)(w, req.WithContext(ctx))
@@ -185,6 +192,7 @@ func (r *RegionSuite) Test_FindRegion_Success() {
 		CreatedAt: time.Now().UTC(),
 		UpdatedAt: time.Now().UTC(),
 	}
+	query.Select("id", "name", "code", "createdAt", "updatedAt")
 	r.repo.On("Find", ctx, query).Return(res, nil)
 
 	handler.FindRegion(schema)(w, req.WithContext(ctx))
@@ -192,6 +200,243 @@ func (r *RegionSuite) Test_FindRegion_Success() {
 	assert.Equal(r.T(), http.StatusOK, w.Code)
 }
 
+func (r *RegionSuite) Test_FindRegion_Location() {
+	body := []byte(`{"query":"{province(id: \"e81f509f-38ec-42e8-9a1c-8e527977e526\") {name location {lat lng}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query := provider.NewQuery(domain.ProvinceNode)
+	query.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	query.Select("name", "location")
+
+	res := &domain.Region{
+		Name:     "Jawa Barat",
+		Location: &domain.Location{Latitude: -7.090911, Longitude: 107.668887},
+	}
+	r.repo.On("Find", ctx, query).Return(res, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"location":{"lat":-7.090911,"lng":107.668887}`)
+}
+
+func (r *RegionSuite) Test_FindRegion_Name() {
+	body := []byte(`{"query":"{regency(name: \"kab bandung\") {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	search := &domain.Search{
+		Text:   "bandung",
+		Levels: []string{domain.RegencyNode},
+		Limit:  config.MaxLimit,
+	}
+	hits := []*domain.Region{
+		{ID: uuid.NewV4().String(), Name: "Bandung Barat", Level: domain.RegencyNode},
+		{ID: "e81f509f-38ec-42e8-9a1c-8e527977e526", Name: "Bandung", Level: domain.RegencyNode},
+	}
+	r.repo.On("Search", ctx, search).Return(hits, nil)
+
+	query := provider.NewQuery("Regency")
+	query.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	query.Select("id", "name")
+
+	res := &domain.Region{ID: "e81f509f-38ec-42e8-9a1c-8e527977e526", Name: "Bandung", Code: "32.04"}
+	r.repo.On("Find", ctx, query).Return(res, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"name":"Bandung"`)
+}
+
+func (r *RegionSuite) Test_FindRegion_NameCurrentSpelling() {
+	body := []byte(`{"query":"{district(name: \"Kec. Oebobo\") {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	search := &domain.Search{
+		Text:   "oebobo",
+		Levels: []string{domain.DistrictNode},
+		Limit:  config.MaxLimit,
+	}
+	hits := []*domain.Region{{ID: "e81f509f-38ec-42e8-9a1c-8e527977e526", Name: "Oebobo", Level: domain.DistrictNode}}
+	r.repo.On("Search", ctx, search).Return(hits, nil)
+
+	query := provider.NewQuery(domain.DistrictNode)
+	query.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	query.Select("id", "name")
+
+	res := &domain.Region{ID: "e81f509f-38ec-42e8-9a1c-8e527977e526", Name: "Oebobo", Code: "53.71.01"}
+	r.repo.On("Find", ctx, query).Return(res, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"name":"Oebobo"`)
+	r.repo.AssertNumberOfCalls(r.T(), "Search", 1)
+}
+
+func (r *RegionSuite) Test_FindRegion_NameOldSpelling() {
+	body := []byte(`{"query":"{city(name: \"Kota Soerabaja\") {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.repo.On("Search", ctx, &domain.Search{Text: "soerabaja", Levels: []string{domain.CityNode}, Limit: config.MaxLimit}).
+		Return([]*domain.Region{}, nil)
+	r.repo.On("Search", ctx, &domain.Search{Text: "surabaja", Levels: []string{domain.CityNode}, Limit: config.MaxLimit}).
+		Return([]*domain.Region{{ID: "e81f509f-38ec-42e8-9a1c-8e527977e526", Name: "Surabaja", Level: domain.CityNode}}, nil)
+
+	query := provider.NewQuery(domain.CityNode)
+	query.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	query.Select("id", "name")
+
+	res := &domain.Region{ID: "e81f509f-38ec-42e8-9a1c-8e527977e526", Name: "Surabaja", Code: "35.78"}
+	r.repo.On("Find", ctx, query).Return(res, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"name":"Surabaja"`)
+}
+
+func (r *RegionSuite) Test_FindRegion_NameOtherLevel() {
+	body := []byte(`{"query":"{regency(name: \"Kota Bandung\") {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.repo.On("Search", ctx, &domain.Search{Text: "kota bandung", Levels: []string{domain.RegencyNode}, Limit: config.MaxLimit}).
+		Return([]*domain.Region{}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusNotFound, w.Code)
+	r.repo.AssertNumberOfCalls(r.T(), "Search", 1)
+}
+
+func (r *RegionSuite) Test_FindRegion_NamePrefixOfName() {
+	body := []byte(`{"query":"{district(name: \"Kota Baru\") {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.repo.On("Search", ctx, &domain.Search{Text: "kota baru", Levels: []string{domain.DistrictNode}, Limit: config.MaxLimit}).
+		Return([]*domain.Region{
+			{ID: "e81f509f-38ec-42e8-9a1c-8e527977e526", Name: "Kota Baru", Level: domain.DistrictNode},
+			{ID: "9b2f6c4e-6f0b-4a44-8d1f-0f3d4a1c2b7e", Name: "Baru", Level: domain.DistrictNode},
+		}, nil)
+
+	query := provider.NewQuery(domain.DistrictNode)
+	query.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	query.Select("id", "name")
+
+	res := &domain.Region{ID: "e81f509f-38ec-42e8-9a1c-8e527977e526", Name: "Kota Baru", Code: "18.72.06"}
+	r.repo.On("Find", ctx, query).Return(res, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"name":"Kota Baru"`)
+}
+
+func (r *RegionSuite) Test_FindRegion_NameMoreThanOne() {
+	body := []byte(`{"query":"{district(name: \"Kec. Sukajadi\") {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	search := &domain.Search{
+		Text:   "sukajadi",
+		Levels: []string{domain.DistrictNode},
+		Limit:  config.MaxLimit,
+	}
+	hits := []*domain.Region{
+		{ID: uuid.NewV4().String(), Name: "Sukajadi", Level: domain.DistrictNode},
+		{ID: uuid.NewV4().String(), Name: "Sukajadi", Level: domain.DistrictNode},
+	}
+	r.repo.On("Search", ctx, search).Return(hits, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+	r.repo.AssertNotCalled(r.T(), "Find", mock.Anything, mock.Anything)
+}
+
 func (r *RegionSuite) Test_FindListRegion_Success() {
 	body := []byte(`{"query":"{cities(code: \"1\", country: {id: \"e81f509f-38ec-42e8-9a1c-8e527977e526\"}) {id name code createdAt updatedAt}}"}`)
 
@@ -244,6 +489,7 @@ func (r *RegionSuite) Test_FindListRegion_Success() {
 			UpdatedAt: timestamp,
 		},
 	}
+	query.Select("id", "name", "code", "createdAt", "updatedAt")
 	r.repo.On("FindAll", ctx, query).Return(res, nil)
 
 	handler.FindRegion(schema)(w, req.WithContext(ctx))
@@ -251,8 +497,8 @@ func (r *RegionSuite) Test_FindListRegion_Success() {
 	assert.Equal(r.T(), http.StatusOK, w.Code)
 }
 
-func (r *RegionSuite) Test_FindListRegion_Failed() {
-	body := []byte(`{"query":"{cities(country: {id: \"e81f509f-38ec-42e8-9a1c-8e527977e526\"}) {id name code createdAt updatedAt}}"}`)
+func (r *RegionSuite) Test_FindListRegion_Where() {
+	body := []byte(`{"query":"{villages(province: {code: \"32\"}, where: {name: {startsWith: \"Suka\"}}) {id name code}}"}`)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
@@ -267,21 +513,31 @@ func (r *RegionSuite) Test_FindListRegion_Failed() {
 		r.T().Fatal(err)
 	}
 
-	query2 := provider.NewQuery("Country")
-	query2.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
-	query := provider.NewQuery("City")
+	query2 := provider.NewQuery("Province")
+	query2.Filter("code", provider.Equal, "32")
+	query := provider.NewQuery("Village")
+	query.Slice(config.Offset, config.Limit)
 	query.Ordering("name", provider.Ascending)
 	query.Incoming(query2)
+	query.Filter("name", provider.StartsWith, "Suka")
 
-	r.repo.On("FindAll", ctx, query).Return(([]*domain.Region)(nil), nil)
+	res := []*domain.Region{
+		{
+			ID:   uuid.NewV4().String(),
+			Name: "Sukamaju",
+			Code: "32.01.01.2001",
+		},
+	}
+	query.Select("id", "name", "code")
+	r.repo.On("FindAll", ctx, query).Return(res, nil)
 
 	handler.FindRegion(schema)(w, req.WithContext(ctx))
 
-	assert.Equal(r.T(), http.StatusBadRequest, w.Code)
+	assert.Equal(r.T(), http.StatusOK, w.Code)
 }
 
-func (r *RegionSuite) Test_FindListRegion_Error() {
-	body := []byte(`{"query":"{cities(country: {id: \"e81f509f-38ec-42e8-9a1c-8e527977e526\"}) {id name code createdAt updatedAt}}"}`)
+func (r *RegionSuite) Test_FindListRegion_WhereGroup() {
+	body := []byte(`{"query":"{regencies(where: {or: [{province: {code: {equal: \"31\"}}}, {province: {code: {equal: \"32\"}}}], not: {name: {contains: \"Kepulauan\"}}}) {id name code}}"}`)
 
 	w := httptest.NewRecorder()
 	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
@@ -296,16 +552,1193 @@ func (r *RegionSuite) Test_FindListRegion_Error() {
 		r.T().Fatal(err)
 	}
 
-	query2 := provider.NewQuery("Country")
-	query2.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
-	query := provider.NewQuery("City")
+	jakarta := provider.NewFilter("code", provider.Equal, "31")
+	jakarta.Node = "Province"
+	westJava := provider.NewFilter("code", provider.Equal, "32")
+	westJava.Node = "Province"
+
+	query := provider.NewQuery("Regency")
 	query.Slice(config.Offset, config.Limit)
 	query.Ordering("name", provider.Ascending)
-	query.Incoming(query2)
+	query.Group(provider.Not, provider.NewFilter("name", provider.Contains, "Kepulauan"))
+	query.Group(provider.Or, provider.NewGroup(provider.And, jakarta), provider.NewGroup(provider.And, westJava))
+	query.Incoming(provider.NewQuery("Province"))
 
-	r.repo.On("FindAll", ctx, query).Return(([]*domain.Region)(nil), assert.AnError)
+	res := []*domain.Region{
+		{
+			ID:   uuid.NewV4().String(),
+			Name: "Bandung",
+			Code: "32.04",
+		},
+	}
+	query.Select("id", "name", "code")
+	r.repo.On("FindAll", ctx, query).Return(res, nil)
 
 	handler.FindRegion(schema)(w, req.WithContext(ctx))
 
-	assert.Equal(r.T(), http.StatusInternalServerError, w.Code)
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+}
+
+func (r *RegionSuite) Test_FindListRegion_OrderBy() {
+	body := []byte(`{"query":"{regencies(orderBy: [{field: CODE, direction: DESC}, {field: NAME}, {field: CODE}]) {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("Regency")
+	query.Ordering("code", provider.Descending)
+	query.Ordering("name", provider.Ascending)
+	query.Slice(config.Offset, config.Limit)
+	query.Select("id", "name")
+
+	res := []*domain.Region{
+		{ID: uuid.NewV4().String(), Name: "Kabupaten Pangandaran", Code: "32.18"},
+		{ID: uuid.NewV4().String(), Name: "Kabupaten Bandung Barat", Code: "32.17"},
+	}
+	r.repo.On("FindAll", ctx, query).Return(res, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	r.repo.AssertExpectations(r.T())
+}
+
+func (r *RegionSuite) Test_FindListRegion_OrderByInvalidField() {
+	body := []byte(`{"query":"{regencies(orderBy: [{field: DIAL_CODE}]) {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusBadRequest, w.Code)
+	r.repo.AssertNotCalled(r.T(), "FindAll", mock.Anything, mock.Anything)
+}
+
+func (r *RegionSuite) Test_FindListRegion_InvalidLimit() {
+	body := []byte(`{"query":"{villages(limit: 0) {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+	r.repo.AssertNotCalled(r.T(), "FindAll", mock.Anything, mock.Anything)
+}
+
+func (r *RegionSuite) Test_FindListRegion_Failed() {
+	body := []byte(`{"query":"{cities(country: {id: \"e81f509f-38ec-42e8-9a1c-8e527977e526\"}) {id name code createdAt updatedAt}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query2 := provider.NewQuery("Country")
+	query2.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	query := provider.NewQuery("City")
+	query.Ordering("name", provider.Ascending)
+	query.Incoming(query2)
+
+	r.repo.On("FindAll", ctx, query).Return(([]*domain.Region)(nil), nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusBadRequest, w.Code)
+}
+
+func (r *RegionSuite) Test_FindListRegion_Error() {
+	body := []byte(`{"query":"{cities(country: {id: \"e81f509f-38ec-42e8-9a1c-8e527977e526\"}) {id name code createdAt updatedAt}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query2 := provider.NewQuery("Country")
+	query2.Filter("id", provider.Equal, "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	query := provider.NewQuery("City")
+	query.Slice(config.Offset, config.Limit)
+	query.Ordering("name", provider.Ascending)
+	query.Incoming(query2)
+
+	query.Select("id", "name", "code", "createdAt", "updatedAt")
+	r.repo.On("FindAll", ctx, query).Return(([]*domain.Region)(nil), assert.AnError)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusInternalServerError, w.Code)
+}
+
+func (r *RegionSuite) Test_FindListRegion_CountryCurrency() {
+	body := []byte(`{"query":"{provinces(country: {currency: {ISO4217Alphabetic: \"IDR\"}}) {name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	country := provider.NewQuery("Country")
+	country.Outgoing(provider.NewQuery("Currency").Filter("ISO4217Alphabetic", provider.Equal, "IDR"))
+	query := provider.NewQuery("Province")
+	query.Slice(config.Offset, config.Limit)
+	query.Ordering("name", provider.Ascending)
+	query.Incoming(country)
+	query.Select("name")
+
+	r.repo.On("FindAll", ctx, query).Return([]*domain.Region{{Name: "Jawa Barat"}}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), "Jawa Barat")
+}
+
+func (r *RegionSuite) Test_FindListRegion_UnknownProperty() {
+	body := []byte(`{"query":"{cities {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.repo.On("FindAll", ctx, mock.Anything).Return(([]*domain.Region)(nil), &provider.PropertyError{Node: "City", Field: "dialCode"})
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `unknown property \"dialCode\" of City`)
+}
+
+func (r *RegionSuite) Test_FindRegionConnection_Success() {
+	body := []byte(`{"query":"{citiesConnection(first: 2, province: {code: \"32\"}) {totalCount edges {cursor node {id name}} pageInfo {hasNextPage hasPreviousPage endCursor}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query2 := provider.NewQuery("Province")
+	query2.Filter("code", provider.Equal, "32")
+	count := provider.NewQuery("City")
+	count.Ordering("name", provider.Ascending)
+	count.Ordering("id", provider.Ascending)
+	count.Incoming(query2)
+
+	query := count.Copy()
+	query.Slice(0, 3)
+
+	res := []*domain.Region{
+		{ID: uuid.NewV4().String(), Name: "Bandung", Code: "32.73"},
+		{ID: uuid.NewV4().String(), Name: "Bekasi", Code: "32.75"},
+		{ID: uuid.NewV4().String(), Name: "Bogor", Code: "32.71"},
+	}
+	query.Select("id", "name")
+	r.repo.On("FindAll", ctx, query).Return(res, nil)
+	r.repo.On("Count", ctx, count).Return(9, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	cursor, _ := provider.EncodeCursor(count.Orderings, "Bekasi", res[1].ID)
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"totalCount":9`)
+	assert.Contains(r.T(), w.Body.String(), `"hasNextPage":true`)
+	assert.Contains(r.T(), w.Body.String(), `"hasPreviousPage":false`)
+	assert.Contains(r.T(), w.Body.String(), fmt.Sprintf(`"endCursor":"%s"`, cursor))
+	assert.NotContains(r.T(), w.Body.String(), "Bogor")
+}
+
+func (r *RegionSuite) Test_FindRegionConnection_OrderBy() {
+	body := []byte(`{"query":"{citiesConnection(first: 1, orderBy: [{field: CODE, direction: DESC}]) {edges {cursor node {name}}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("City")
+	query.Ordering("code", provider.Descending)
+	query.Ordering("id", provider.Ascending)
+	query.Slice(0, 2)
+	query.Select("name", "code", "id")
+
+	res := []*domain.Region{
+		{ID: uuid.NewV4().String(), Name: "Banjar", Code: "32.79"},
+		{ID: uuid.NewV4().String(), Name: "Cimahi", Code: "32.77"},
+	}
+	r.repo.On("FindAll", ctx, query).Return(res, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	cursor, _ := provider.EncodeCursor([]*provider.Ordering{
+		provider.NewOrdering("code", provider.Descending),
+		provider.NewOrdering("id", provider.Ascending),
+	}, "32.79", res[0].ID)
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), fmt.Sprintf(`"cursor":"%s"`, cursor))
+	assert.NotContains(r.T(), w.Body.String(), "Cimahi")
+}
+
+func (r *RegionSuite) Test_FindRegionConnection_Backward() {
+	before, _ := provider.EncodeCursor([]*provider.Ordering{
+		provider.NewOrdering("name", provider.Ascending),
+		provider.NewOrdering("id", provider.Ascending),
+	}, "Bogor", "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	body := []byte(fmt.Sprintf(`{"query":"{citiesConnection(last: 2, before: \"%s\") {edges {node {name}} pageInfo {hasNextPage hasPreviousPage}}}"}`, before))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("City")
+	query.Ordering("name", provider.Descending)
+	query.Ordering("id", provider.Descending)
+	query.Seek([]interface{}{"Bogor", "e81f509f-38ec-42e8-9a1c-8e527977e526"})
+	query.Slice(0, 3)
+
+	res := []*domain.Region{
+		{ID: uuid.NewV4().String(), Name: "Bekasi", Code: "32.75"},
+		{ID: uuid.NewV4().String(), Name: "Bandung", Code: "32.73"},
+	}
+	query.Select("name", "id")
+	r.repo.On("FindAll", ctx, query).Return(res, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"edges":[{"node":{"name":"Bandung"}},{"node":{"name":"Bekasi"}}]`)
+	assert.Contains(r.T(), w.Body.String(), `"hasNextPage":true`)
+	assert.Contains(r.T(), w.Body.String(), `"hasPreviousPage":false`)
+}
+
+func (r *RegionSuite) Test_FindRegionConnection_InvalidCursor() {
+	body := []byte(`{"query":"{citiesConnection(after: \"<-\") {edges {cursor}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+}
+
+func (r *RegionSuite) Test_FindRegionConnection_CursorOtherOrder() {
+	after, _ := provider.EncodeCursor([]*provider.Ordering{
+		provider.NewOrdering("name", provider.Ascending),
+		provider.NewOrdering("id", provider.Ascending),
+	}, "Bogor", "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	body := []byte(fmt.Sprintf(`{"query":"{citiesConnection(after: \"%s\", orderBy: [{field: CODE}]) {edges {cursor}}}"}`, after))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+	r.repo.AssertNotCalled(r.T(), "FindAll", mock.Anything, mock.Anything)
+}
+
+func (r *RegionSuite) Test_CountRegion_Success() {
+	body := []byte(`{"query":"{villagesCount(district: {code: \"32.04.01\"})}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query2 := provider.NewQuery("District")
+	query2.Filter("code", provider.Equal, "32.04.01")
+	query := provider.NewQuery("Village")
+	query.Ordering("name", provider.Ascending)
+	query.Incoming(query2)
+
+	r.repo.On("Count", ctx, query).Return(11, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"villagesCount":11`)
+}
+
+func (r *RegionSuite) Test_CountRegion_Error() {
+	body := []byte(`{"query":"{villagesCount}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("Village")
+	query.Ordering("name", provider.Ascending)
+
+	r.repo.On("Count", ctx, query).Return(0, assert.AnError)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusInternalServerError, w.Code)
+}
+
+func (r *RegionSuite) Test_AggregateRegion_Success() {
+	body := []byte(`{"query":"{regionCounts(level: DISTRICT, groupBy: REGENCY, province: {code: \"32\"}) {group {name code} total}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query2 := provider.NewQuery("Province")
+	query2.Filter("code", provider.Equal, "32")
+	query := provider.NewQuery("District")
+	query.Ordering("name", provider.Ascending)
+	query.Incoming(query2)
+	query.Incoming(provider.NewQuery("Regency"))
+
+	res := []*domain.RegionCount{
+		{
+			Group: &domain.Region{ID: uuid.NewV4().String(), Name: "Bandung", Code: "32.04"},
+			Total: 31,
+		},
+	}
+	r.repo.On("Aggregate", ctx, query, "Regency").Return(res, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `{"group":{"code":"32.04","name":"Bandung"},"total":31}`)
+}
+
+func (r *RegionSuite) Test_AggregateRegion_SameLevel() {
+	body := []byte(`{"query":"{regionCounts(level: DISTRICT, groupBy: DISTRICT) {total}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+}
+
+func (r *RegionSuite) Test_AggregateRegion_LowerLevel() {
+	for _, body := range [][]byte{
+		[]byte(`{"query":"{regionCounts(level: PROVINCE, groupBy: DISTRICT) {total}}"}`),
+		[]byte(`{"query":"{regionCounts(level: REGENCY, groupBy: CITY) {total}}"}`),
+	} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+		req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+		ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+		schema, err := graphql.NewSchema(graphql.SchemaConfig{
+			Query: handler.RegionQuery(r.repo),
+		})
+		if err != nil {
+			r.T().Fatal(err)
+		}
+
+		handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+		assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+	}
+	r.repo.AssertNotCalled(r.T(), "Aggregate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (r *RegionSuite) Test_SearchRegion_Success() {
+	body := []byte(`{"query":"{search(text: \"kab bandung\", levels: [CITY, REGENCY], country: {ISO3166Alpha2: \"ID\"}, limit: 5) {__typename id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	search := &domain.Search{
+		Text:    "bandung",
+		Levels:  []string{domain.CityNode, domain.RegencyNode},
+		Country: provider.NewQuery(domain.CountryNode).Filter("ISO3166Alpha2", provider.Equal, "ID"),
+		Limit:   5,
+	}
+
+	res := []*domain.Region{
+		{ID: uuid.NewV4().String(), Name: "Kabupaten Bandung", Code: "32.04", Level: domain.RegencyNode},
+		{ID: uuid.NewV4().String(), Name: "Kota Bandung", Code: "32.73", Level: domain.CityNode},
+	}
+	r.repo.On("Search", ctx, search).Return(res, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `{"__typename":"Regency"`)
+	assert.Contains(r.T(), w.Body.String(), `{"__typename":"City"`)
+}
+
+func (r *RegionSuite) Test_SearchRegion_Currency() {
+	body := []byte(`{"query":"{search(text: \"jawa\", country: {currency: {ISO4217Alphabetic: \"IDR\"}}) {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	country := provider.NewQuery(domain.CountryNode)
+	country.Outgoing(provider.NewQuery(domain.CurrencyNode).Filter("ISO4217Alphabetic", provider.Equal, "IDR"))
+
+	r.repo.On("Search", ctx, &domain.Search{Text: "jawa", Country: country, Limit: config.Limit}).
+		Return([]*domain.Region{{ID: uuid.NewV4().String(), Name: "Jawa Barat", Level: domain.ProvinceNode}}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"name":"Jawa Barat"`)
+}
+
+func (r *RegionSuite) Test_SearchRegion_PrefixOfName() {
+	body := []byte(`{"query":"{search(text: \"Kota Baru\") {__typename name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.repo.On("Search", ctx, &domain.Search{Text: "baru", Limit: config.Limit}).Return([]*domain.Region{
+		{ID: uuid.NewV4().String(), Name: "Kota Baru", Level: domain.DistrictNode},
+		{ID: uuid.NewV4().String(), Name: "Kotabaru", Level: domain.CityNode},
+	}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `[{"__typename":"City","name":"Kotabaru"},{"__typename":"District","name":"Kota Baru"}]`)
+}
+
+func (r *RegionSuite) Test_SearchRegion_WholeName() {
+	body := []byte(`{"query":"{search(text: \"Kota Agung\") {__typename name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.repo.On("Search", ctx, &domain.Search{Text: "agung", Limit: config.Limit}).Return([]*domain.Region{}, nil)
+	r.repo.On("Search", ctx, &domain.Search{Text: "kota agung", Limit: config.Limit}).
+		Return([]*domain.Region{{ID: uuid.NewV4().String(), Name: "Kota Agung", Level: domain.DistrictNode}}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `{"__typename":"District","name":"Kota Agung"}`)
+}
+
+func (r *RegionSuite) Test_SearchRegion_OldSpelling() {
+	body := []byte(`{"query":"{search(text: \"Djakarta\") {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.repo.On("Search", ctx, &domain.Search{Text: "djakarta", Limit: config.Limit}).Return([]*domain.Region{}, nil)
+	r.repo.On("Search", ctx, &domain.Search{Text: "jakarta", Limit: config.Limit}).
+		Return([]*domain.Region{{ID: uuid.NewV4().String(), Name: "DKI Jakarta", Level: domain.ProvinceNode}}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"name":"DKI Jakarta"`)
+}
+
+func (r *RegionSuite) Test_SearchRegion_EmptyText() {
+	body := []byte(`{"query":"{search(text: \" . \") {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+	r.repo.AssertNotCalled(r.T(), "Search", mock.Anything, mock.Anything)
+}
+
+func (r *RegionSuite) Test_SearchRegion_Error() {
+	body := []byte(`{"query":"{search(text: \"jogja\") {id name}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	search := &domain.Search{Text: "jogja", Limit: config.Limit}
+	r.repo.On("Search", ctx, search).Return(([]*domain.Region)(nil), assert.AnError)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusInternalServerError, w.Code)
+}
+
+func (r *RegionSuite) Test_ParseAddress_Success() {
+	body := []byte(`{"query":"{parseAddress(text: \"Kec. Coblong, Jawa Barat 40135\") {confidence district {code path {... on Province {code}}} province {code} village {code}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	province := &domain.Region{ID: uuid.NewV4().String(), Name: "Jawa Barat", Code: "32", Level: domain.ProvinceNode}
+	city := &domain.Region{ID: uuid.NewV4().String(), Name: "Bandung", Code: "32.73", Level: domain.CityNode}
+	district := &domain.Region{ID: uuid.NewV4().String(), Name: "Coblong", Code: "32.73.02", Level: domain.DistrictNode}
+
+	r.repo.On("SearchAll", ctx, []*domain.Search{
+		{Text: "coblong", Levels: []string{domain.DistrictNode}, Limit: 10},
+		{Text: "jawa barat", Limit: 10},
+	}).Return([][]*domain.Region{{district}, {province}}, nil)
+	r.repo.On("Ancestors", ctx, provider.NewQuery(domain.ProvinceNode).Filter("id", provider.In, province.ID)).
+		Return([]*domain.Ancestors{{ID: province.ID}}, nil)
+	r.repo.On("Ancestors", ctx, provider.NewQuery(domain.DistrictNode).Filter("id", provider.In, district.ID)).
+		Return([]*domain.Ancestors{{ID: district.ID, Path: []*domain.Region{province, city}}}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"district":{"code":"32.73.02","path":[{"code":"32"},{}]}`)
+	assert.Contains(r.T(), w.Body.String(), `"province":{"code":"32"},"village":null`)
+}
+
+func (r *RegionSuite) Test_ParseAddress_EmptyText() {
+	body := []byte(`{"query":"{parseAddress(text: \"40135\") {confidence}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+	r.repo.AssertNotCalled(r.T(), "SearchAll", mock.Anything, mock.Anything)
+}
+
+func (r *RegionSuite) Test_ParseAddress_LimitExceeded() {
+	body := []byte(`{"query":"{parseAddress(text: \"Kec. Coblong, Jawa Barat\", limit: 1000) {confidence}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+	r.repo.AssertNotCalled(r.T(), "SearchAll", mock.Anything, mock.Anything)
+}
+
+func (r *RegionSuite) Test_ParseAddress_TextTooLong() {
+	body := []byte(`{"query":"{parseAddress(text: \"` + strings.Repeat("Coblong ", 40) + `\") {confidence}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+	r.repo.AssertNotCalled(r.T(), "SearchAll", mock.Anything, mock.Anything)
+}
+
+func (r *RegionSuite) Test_Locate_Success() {
+	body := []byte(`{"query":"{locate(lat: -6.89, lng: 107.69) {__typename code ... on District {province {code}}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	id := "e81f509f-38ec-42e8-9a1c-8e527977e526"
+	for _, level := range domain.RegionNodes {
+		var boxes []*domain.Boundary
+		if level == domain.DistrictNode {
+			boxes = []*domain.Boundary{{ID: id, Code: "32.73.02", Level: level, BBox: []float64{107.6, -6.9, 107.7, -6.8}}}
+		}
+		r.repo.On("BoundingBoxes", ctx, provider.NewQuery(level)).Return(boxes, nil)
+	}
+	r.repo.On("Boundaries", ctx, provider.NewQuery(domain.DistrictNode).Filter("id", provider.In, id)).
+		Return([]*domain.Boundary{{ID: id, Code: "32.73.02", Level: domain.DistrictNode,
+			Geometry: `{"type":"Polygon","coordinates":[[[107.6,-6.9],[107.7,-6.9],[107.7,-6.8],[107.6,-6.9]]]}`}}, nil)
+
+	query := provider.NewQuery(domain.DistrictNode).Filter("id", provider.Equal, id)
+	query.Select("code")
+	r.repo.On("Find", ctx, query).Return(&domain.Region{ID: id, Code: "32.73.02"}, nil)
+	r.repo.On("Ancestors", ctx, provider.NewQuery(domain.DistrictNode).Filter("id", provider.In, id)).
+		Return([]*domain.Ancestors{{ID: id, Path: []*domain.Region{{Code: "32", Level: domain.ProvinceNode}}}}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"locate":{"__typename":"District","code":"32.73.02","province":{"code":"32"}}`)
+}
+
+func (r *RegionSuite) Test_Locate_OutOfRange() {
+	body := []byte(`{"query":"{locate(lat: 107.69, lng: -6.89) {code}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+}
+
+func (r *RegionSuite) Test_Nearby_Success() {
+	body := []byte(`{"query":"{nearby(lat: -6.89, lng: 107.61, radiusKm: 10, level: DISTRICT, province: {code: \"32\"}) {__typename code distanceKm}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query := provider.NewQuery(domain.DistrictNode)
+	query.Ordering("name", provider.Ascending)
+	query.Incoming(provider.NewQuery(domain.ProvinceNode).Filter("code", provider.Equal, "32"))
+	query.Near("location", -6.89, 107.61, 10000)
+	query.Slice(0, config.Limit)
+	query.Select("code")
+
+	near, far := 1250.0, 8400.0
+	r.repo.On("FindAll", ctx, query).Return([]*domain.Region{
+		{Code: "32.73.02", Distance: &near},
+		{Code: "32.73.01", Distance: &far},
+	}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"nearby":[{"__typename":"District","code":"32.73.02","distanceKm":1.25},`+
+		`{"__typename":"District","code":"32.73.01","distanceKm":8.4}]`)
+}
+
+func (r *RegionSuite) Test_Nearest_Success() {
+	body := []byte(`{"query":"{nearest(lat: -6.89, lng: 107.61, level: PROVINCE) {code}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query := provider.NewQuery(domain.ProvinceNode)
+	query.Ordering("name", provider.Ascending)
+	query.Near("location", -6.89, 107.61, 0)
+	query.Slice(0, 1)
+	query.Select("code")
+
+	r.repo.On("FindAll", ctx, query).Return([]*domain.Region{{Code: "32"}}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"nearest":{"code":"32"}`)
+}
+
+func (r *RegionSuite) Test_Nearby_InvalidRadius() {
+	body := []byte(`{"query":"{nearby(lat: -6.89, lng: 107.61, radiusKm: 0, level: DISTRICT) {code}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+}
+
+func (r *RegionSuite) Test_ValidateRegions_BrokenLink() {
+	body := []byte(`{"query":"{validateRegions(province: \"32\", city: \"33.74\") {valid regions {level code exists} links {parent child valid actualParentCode}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.repo.On("Ancestors", ctx, provider.NewQuery(domain.ProvinceNode).Filter("code", provider.Equal, "32")).
+		Return([]*domain.Ancestors{{ID: uuid.NewV4().String()}}, nil)
+	r.repo.On("Ancestors", ctx, provider.NewQuery(domain.CityNode).Filter("code", provider.Equal, "33.74")).
+		Return([]*domain.Ancestors{{ID: uuid.NewV4().String(), Path: []*domain.Region{{Code: "33", Level: domain.ProvinceNode}}}}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"valid":false`)
+	assert.Contains(r.T(), w.Body.String(), `{"code":"33.74","exists":true,"level":"CITY"}`)
+	assert.Contains(r.T(), w.Body.String(), `"links":[{"actualParentCode":"33","child":"CITY","parent":"PROVINCE","valid":false}]`)
+}
+
+func (r *RegionSuite) Test_ValidateRegions_Empty() {
+	body := []byte(`{"query":"{validateRegions {valid}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusPreconditionFailed, w.Code)
+}
+
+func (r *RegionSuite) Test_FindRegion_Ancestors() {
+	body := []byte(`{"query":"{village(code: \"32.04.01.2001\") {name parent {... on District {name}} province {code} ` +
+		`country {name} path {... on Country {name} ... on Province {name} ... on Regency {name} ... on District {name}}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("Village")
+	query.Filter("code", provider.Equal, "32.04.01.2001")
+
+	res := &domain.Region{
+		ID:   uuid.NewV4().String(),
+		Name: "Cilame",
+		Code: "32.04.01.2001",
+	}
+	query.Select("name")
+	r.repo.On("Find", ctx, query).Return(res, nil)
+
+	query2 := provider.NewQuery("Village")
+	query2.Filter("id", provider.In, res.ID)
+
+	ancestors := []*domain.Ancestors{
+		{
+			ID:      res.ID,
+			Country: &domain.Country{Name: "Indonesia"},
+			Path: []*domain.Region{
+				{Name: "Jawa Barat", Code: "32", Level: domain.ProvinceNode},
+				{Name: "Bandung", Code: "32.04", Level: domain.RegencyNode},
+				{Name: "Cileunyi", Code: "32.04.01", Level: domain.DistrictNode},
+			},
+		},
+	}
+	r.repo.On("Ancestors", ctx, query2).Return(ancestors, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"parent":{"name":"Cileunyi"}`)
+	assert.Contains(r.T(), w.Body.String(), `"province":{"code":"32"}`)
+	assert.Contains(r.T(), w.Body.String(), `"country":{"name":"Indonesia"}`)
+	assert.Contains(r.T(), w.Body.String(), `"path":[{"name":"Indonesia"},{"name":"Jawa Barat"},{"name":"Bandung"},{"name":"Cileunyi"}]`)
+}
+
+func (r *RegionSuite) Test_FindListRegion_AncestorsError() {
+	body := []byte(`{"query":"{districts(code: \"32.04.01\") {name regency {name}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("District")
+	query.Ordering("name", provider.Ascending)
+	query.Filter("code", provider.Equal, "32.04.01")
+	query.Slice(0, 25)
+
+	res := []*domain.Region{
+		{ID: uuid.NewV4().String(), Name: "Cileunyi", Code: "32.04.01"},
+	}
+	query.Select("name")
+	r.repo.On("FindAll", ctx, query).Return(res, nil)
+
+	query2 := provider.NewQuery("District")
+	query2.Filter("id", provider.In, res[0].ID)
+
+	r.repo.On("Ancestors", ctx, query2).Return([]*domain.Ancestors(nil), assert.AnError)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusInternalServerError, w.Code)
+}
+
+func (r *RegionSuite) Test_FindListRegion_Projection() {
+	body := []byte(`{"query":"{provinces(code: \"32\") {name regencies {name ...district}}} fragment district on Regency {districts {code}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+	ctx = domain.WithRegionLoader(ctx, region.NewLoader(r.repo))
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query := provider.NewQuery("Province")
+	query.Filter("code", provider.Equal, "32")
+	query.Slice(config.Offset, config.Limit)
+	query.Ordering("name", provider.Ascending)
+	query.Select("name")
+	regencies := query.ExpansionOf("regencies", domain.RegencyNode).Select("name")
+	regencies.ExpansionOf("districts", domain.DistrictNode).Select("code")
+
+	res := []*domain.Region{
+		{ID: uuid.NewV4().String(), Name: "Jawa Barat", Regions: domain.Regions{domain.RegencyNode: []*domain.Region{
+			{ID: uuid.NewV4().String(), Name: "Bandung", Regions: domain.Regions{domain.DistrictNode: []*domain.Region{{Code: "32.04.01"}}}},
+		}}},
+		{ID: uuid.NewV4().String(), Name: "Jawa Tengah", Regions: domain.Regions{domain.RegencyNode: []*domain.Region{
+			{ID: uuid.NewV4().String(), Name: "Cilacap", Regions: domain.Regions{domain.DistrictNode: []*domain.Region{}}},
+		}}},
+	}
+	r.repo.On("FindAll", ctx, query).Return(res, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `{"name":"Jawa Barat","regencies":[{"districts":[{"code":"32.04.01"}],"name":"Bandung"}]}`)
+	assert.Contains(r.T(), w.Body.String(), `{"name":"Jawa Tengah","regencies":[{"districts":[],"name":"Cilacap"}]}`)
+	r.repo.AssertNotCalled(r.T(), "Children")
+}
+
+func (r *RegionSuite) Test_FindListRegion_Neighbours() {
+	body := []byte(`{"query":"{provinces(code: \"32\") {name neighbours {name}}}"}`)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/regions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", graph.ContentTypeJSON)
+
+	ctx := context.WithValue(req.Context(), cookbook.RequestID, uuid.NewV4().String())
+	ctx = domain.WithRegionLoader(ctx, region.NewLoader(r.repo))
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: handler.RegionQuery(r.repo),
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	query := provider.NewQuery(domain.ProvinceNode)
+	query.Filter("code", provider.Equal, "32")
+	query.Slice(config.Offset, config.Limit)
+	query.Ordering("name", provider.Ascending)
+	query.Select("name")
+
+	id := uuid.NewV4().String()
+	r.repo.On("FindAll", ctx, query).Return([]*domain.Region{{ID: id, Name: "Jawa Barat"}}, nil)
+	r.repo.On("Neighbours", ctx, provider.NewQuery(domain.ProvinceNode).Filter("id", provider.In, id)).
+		Return([]*domain.Region{{ID: id, Neighbours: []*domain.Region{{Name: "Banten"}, {Name: "DKI Jakarta"}, {Name: "Jawa Tengah"}}}}, nil)
+
+	handler.FindRegion(schema)(w, req.WithContext(ctx))
+
+	assert.Equal(r.T(), http.StatusOK, w.Code)
+	assert.Contains(r.T(), w.Body.String(), `"provinces":[{"name":"Jawa Barat","neighbours":[{"name":"Banten"},{"name":"DKI Jakarta"},{"name":"Jawa Tengah"}]}]`)
 }
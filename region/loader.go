@@ -0,0 +1,178 @@
+package region
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"sync"
+
+	"github.com/dynastymasra/cartographer/domain"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+
+	"github.com/dynastymasra/cookbook"
+	"github.com/sirupsen/logrus"
+)
+
+// Loader batches the child, ancestor, boundary and neighbour lookups of one request, the lookups of the same node label are loaded
+// with one IN query, create new loader for every request so the results are not shared between requests
+type Loader struct {
+	repo    Repository
+	mu      sync.Mutex
+	batches map[string]*provider.Batch
+}
+
+func NewLoader(repo Repository) *Loader {
+	return &Loader{
+		repo:    repo,
+		batches: map[string]*provider.Batch{},
+	}
+}
+
+// Children loads the child nodes of the parent node label with the id
+func (l *Loader) Children(ctx context.Context, parent, id, child string) func() ([]*domain.Region, error) {
+	batch := l.batch("children:"+parent+":"+child, func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: ctx.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(l.Children).Pointer()).Name(),
+		})
+
+		query := provider.NewQuery(parent)
+		for _, id := range ids {
+			query.Filter("id", provider.In, id)
+		}
+
+		results, err := l.repo.Children(ctx, query, child)
+		if err != nil {
+			return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find children from storage")
+		}
+
+		values := make(map[string]interface{}, len(results))
+		for _, result := range results {
+			values[result.ID] = result.Children(child)
+		}
+
+		return values, nil
+	})
+
+	load := batch.Load(ctx, id)
+	return func() ([]*domain.Region, error) {
+		value, err := load()
+		children, _ := value.([]*domain.Region)
+		return children, err
+	}
+}
+
+// Ancestors loads the country and upper administrative divisions of the node label with the id
+func (l *Loader) Ancestors(ctx context.Context, node, id string) func() (*domain.Ancestors, error) {
+	batch := l.batch("ancestors:"+node, func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: ctx.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(l.Ancestors).Pointer()).Name(),
+		})
+
+		query := provider.NewQuery(node)
+		for _, id := range ids {
+			query.Filter("id", provider.In, id)
+		}
+
+		results, err := l.repo.Ancestors(ctx, query)
+		if err != nil {
+			return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find ancestors from storage")
+		}
+
+		values := make(map[string]interface{}, len(results))
+		for _, result := range results {
+			values[result.ID] = result
+		}
+
+		return values, nil
+	})
+
+	load := batch.Load(ctx, id)
+	return func() (*domain.Ancestors, error) {
+		value, err := load()
+		ancestors, _ := value.(*domain.Ancestors)
+		return ancestors, err
+	}
+}
+
+// Boundary loads the boundary geometry of the node label with the id
+func (l *Loader) Boundary(ctx context.Context, node, id string) func() (*domain.Boundary, error) {
+	batch := l.batch("boundary:"+node, func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: ctx.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(l.Boundary).Pointer()).Name(),
+		})
+
+		query := provider.NewQuery(node)
+		for _, id := range ids {
+			query.Filter("id", provider.In, id)
+		}
+
+		results, err := l.repo.Boundaries(ctx, query)
+		if err != nil {
+			return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find boundaries from storage")
+		}
+
+		values := make(map[string]interface{}, len(results))
+		for _, result := range results {
+			values[result.ID] = result
+		}
+
+		return values, nil
+	})
+
+	load := batch.Load(ctx, id)
+	return func() (*domain.Boundary, error) {
+		value, err := load()
+		boundary, _ := value.(*domain.Boundary)
+		return boundary, err
+	}
+}
+
+// Neighbours loads the nodes of the same level sharing a border with the node label with the id
+func (l *Loader) Neighbours(ctx context.Context, node, id string) func() ([]*domain.Region, error) {
+	batch := l.batch("neighbours:"+node, func(ctx context.Context, ids []string) (map[string]interface{}, error) {
+		log := logrus.WithFields(logrus.Fields{
+			cookbook.RequestID: ctx.Value(cookbook.RequestID),
+			"package":          runtime.FuncForPC(reflect.ValueOf(l.Neighbours).Pointer()).Name(),
+		})
+
+		query := provider.NewQuery(node)
+		for _, id := range ids {
+			query.Filter("id", provider.In, id)
+		}
+
+		results, err := l.repo.Neighbours(ctx, query)
+		if err != nil {
+			return nil, domain.StorageError(log.WithField("query", cookbook.Stringify(query)), err, "Failed find neighbours from storage")
+		}
+
+		values := make(map[string]interface{}, len(results))
+		for _, result := range results {
+			values[result.ID] = result.Neighbours
+		}
+
+		return values, nil
+	})
+
+	load := batch.Load(ctx, id)
+	return func() ([]*domain.Region, error) {
+		value, err := load()
+		neighbours, _ := value.([]*domain.Region)
+		return neighbours, err
+	}
+}
+
+func (l *Loader) batch(key string, fetch provider.BatchFunc) *provider.Batch {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	batch, ok := l.batches[key]
+	if !ok {
+		batch = provider.NewBatch(fetch)
+		l.batches[key] = batch
+	}
+
+	return batch
+}
@@ -0,0 +1,131 @@
+package region
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/dynastymasra/cartographer/domain"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+
+	"github.com/dynastymasra/cookbook"
+	"github.com/sirupsen/logrus"
+)
+
+// locatorRefresh is the age of the spatial index before it's built again, so imported boundaries are located
+const locatorRefresh = time.Hour
+
+// Locator finds the administrative division contains the point, the bounding boxes of every boundary are kept
+// in the R-tree and only the geometries of the boxes contain the point are read for the exact test
+type Locator struct {
+	repo  Repository
+	mu    sync.Mutex
+	index *provider.RTree
+	built time.Time
+}
+
+func NewLocator(repo Repository) *Locator {
+	return &Locator{repo: repo}
+}
+
+// Locate returns the boundary of the lowest level contains the point, nil when no boundary contains the point
+func (l *Locator) Locate(ctx context.Context, lat, lng float64) (*domain.Boundary, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(l.Locate).Pointer()).Name(),
+	})
+
+	index, err := l.spatialIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make(map[string][]string)
+	for _, value := range index.Search(lng, lat) {
+		boundary := value.(*domain.Boundary)
+		candidates[boundary.Level] = append(candidates[boundary.Level], boundary.ID)
+	}
+
+	for i := len(domain.RegionNodes) - 1; i >= 0; i-- {
+		level := domain.RegionNodes[i]
+		if len(candidates[level]) < 1 {
+			continue
+		}
+
+		query := provider.NewQuery(level)
+		for _, id := range candidates[level] {
+			query.Filter("id", provider.In, id)
+		}
+
+		boundaries, err := l.repo.Boundaries(ctx, query)
+		if err != nil {
+			log.WithField("query", cookbook.Stringify(query)).WithError(err).Errorln("Failed find boundaries from storage")
+			return nil, err
+		}
+
+		for _, boundary := range boundaries {
+			geometry, err := domain.ParseGeometry([]byte(boundary.Geometry))
+			if err != nil {
+				log.WithField("code", boundary.Code).WithError(err).Warnln("Failed parse boundary geometry")
+				continue
+			}
+
+			if geometry.Contains(domain.Position{lng, lat}) {
+				return boundary, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// spatialIndex returns the R-tree of the bounding boxes, the tree is built on the first call and after it's expired
+func (l *Locator) spatialIndex(ctx context.Context) (*provider.RTree, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.index != nil && time.Since(l.built) < locatorRefresh {
+		return l.index, nil
+	}
+
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(l.spatialIndex).Pointer()).Name(),
+	})
+
+	var entries []*provider.RTreeEntry
+	for _, level := range domain.RegionNodes {
+		query := provider.NewQuery(level)
+
+		boundaries, err := l.repo.BoundingBoxes(ctx, query)
+		if err != nil {
+			log.WithField("query", cookbook.Stringify(query)).WithError(err).Errorln("Failed find bounding boxes from storage")
+			return nil, err
+		}
+
+		for _, boundary := range boundaries {
+			if len(boundary.BBox) != 4 {
+				continue
+			}
+
+			entries = append(entries, &provider.RTreeEntry{
+				Box: provider.Box{
+					MinX: boundary.BBox[0],
+					MinY: boundary.BBox[1],
+					MaxX: boundary.BBox[2],
+					MaxY: boundary.BBox[3],
+				},
+				Value: boundary,
+			})
+		}
+	}
+
+	l.index = provider.NewRTree(entries)
+	l.built = time.Now()
+
+	log.WithField("boundaries", l.index.Len()).Infoln("Built spatial index of boundaries")
+
+	return l.index, nil
+}
@@ -0,0 +1,102 @@
+package region_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/config"
+	"github.com/dynastymasra/cartographer/domain"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+	"github.com/dynastymasra/cartographer/region"
+	"github.com/dynastymasra/cartographer/region/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	jawaBarat = `{"type":"Polygon","coordinates":[[[106,-8],[109,-8],[109,-5.9],[106,-5.9],[106,-8]]]}`
+	coblong   = `{"type":"Polygon","coordinates":[[[107.6,-6.9],[107.7,-6.9],[107.7,-6.8],[107.6,-6.9]]]}`
+)
+
+type LocatorSuite struct {
+	suite.Suite
+	repo *test.MockRepository
+}
+
+func Test_LocatorSuite(t *testing.T) {
+	suite.Run(t, new(LocatorSuite))
+}
+
+func (l *LocatorSuite) SetupSuite() {
+	config.SetupTestLogger()
+}
+
+func (l *LocatorSuite) SetupTest() {
+	l.repo = &test.MockRepository{}
+
+	l.repo.On("BoundingBoxes", mock.Anything, provider.NewQuery(domain.ProvinceNode)).Return([]*domain.Boundary{
+		{ID: "32", Code: "32", Level: domain.ProvinceNode, BBox: []float64{106, -8, 109, -5.9}},
+	}, nil)
+	l.repo.On("BoundingBoxes", mock.Anything, provider.NewQuery(domain.DistrictNode)).Return([]*domain.Boundary{
+		{ID: "32.73.02", Code: "32.73.02", Level: domain.DistrictNode, BBox: []float64{107.6, -6.9, 107.7, -6.8}},
+	}, nil)
+	for _, level := range []string{domain.CityNode, domain.RegencyNode, domain.VillageNode} {
+		l.repo.On("BoundingBoxes", mock.Anything, provider.NewQuery(level)).Return([]*domain.Boundary{}, nil)
+	}
+
+	l.repo.On("Boundaries", mock.Anything, provider.NewQuery(domain.ProvinceNode).Filter("id", provider.In, "32")).
+		Return([]*domain.Boundary{{ID: "32", Code: "32", Level: domain.ProvinceNode, Geometry: jawaBarat}}, nil)
+	l.repo.On("Boundaries", mock.Anything, provider.NewQuery(domain.DistrictNode).Filter("id", provider.In, "32.73.02")).
+		Return([]*domain.Boundary{{ID: "32.73.02", Code: "32.73.02", Level: domain.DistrictNode, Geometry: coblong}}, nil)
+}
+
+func (l *LocatorSuite) Test_Locate_Lowest() {
+	locator := region.NewLocator(l.repo)
+
+	res, err := locator.Locate(context.Background(), -6.89, 107.69)
+
+	assert.NoError(l.T(), err)
+	assert.Equal(l.T(), "32.73.02", res.Code)
+}
+
+func (l *LocatorSuite) Test_Locate_OutsideLowerBoundary() {
+	locator := region.NewLocator(l.repo)
+
+	// inside the bounding box of the district but outside the triangle
+	res, err := locator.Locate(context.Background(), -6.81, 107.61)
+
+	assert.NoError(l.T(), err)
+	assert.Equal(l.T(), "32", res.Code)
+}
+
+func (l *LocatorSuite) Test_Locate_NotFound() {
+	locator := region.NewLocator(l.repo)
+
+	res, err := locator.Locate(context.Background(), 1.5, 124.8)
+
+	assert.NoError(l.T(), err)
+	assert.Nil(l.T(), res)
+	l.repo.AssertNotCalled(l.T(), "Boundaries", mock.Anything, mock.Anything)
+}
+
+func (l *LocatorSuite) Test_Locate_IndexOnce() {
+	locator := region.NewLocator(l.repo)
+
+	_, _ = locator.Locate(context.Background(), -6.89, 107.69)
+	_, _ = locator.Locate(context.Background(), -6.81, 107.61)
+
+	l.repo.AssertNumberOfCalls(l.T(), "BoundingBoxes", len(domain.RegionNodes))
+}
+
+func (l *LocatorSuite) Test_Locate_Error() {
+	repo := &test.MockRepository{}
+	repo.On("BoundingBoxes", mock.Anything, mock.Anything).Return(([]*domain.Boundary)(nil), assert.AnError)
+
+	locator := region.NewLocator(repo)
+	res, err := locator.Locate(context.Background(), -6.89, 107.69)
+
+	assert.Nil(l.T(), res)
+	assert.Error(l.T(), err)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
 
 	"github.com/dynastymasra/cartographer/domain"
@@ -19,6 +20,15 @@ import (
 type Repository interface {
 	Find(context.Context, *provider.Query) (*domain.Region, error)
 	FindAll(context.Context, *provider.Query) ([]*domain.Region, error)
+	Count(context.Context, *provider.Query) (int, error)
+	Aggregate(context.Context, *provider.Query, string) ([]*domain.RegionCount, error)
+	Ancestors(context.Context, *provider.Query) ([]*domain.Ancestors, error)
+	Children(context.Context, *provider.Query, string) ([]*domain.Region, error)
+	Search(context.Context, *domain.Search) ([]*domain.Region, error)
+	SearchAll(context.Context, []*domain.Search) ([][]*domain.Region, error)
+	Boundaries(context.Context, *provider.Query) ([]*domain.Boundary, error)
+	BoundingBoxes(context.Context, *provider.Query) ([]*domain.Boundary, error)
+	Neighbours(context.Context, *provider.Query) ([]*domain.Region, error)
 }
 
 type RepositoryInstance struct {
@@ -42,8 +52,16 @@ func (r *RepositoryInstance) Find(ctx context.Context, query *provider.Query) (*
 	}
 	defer session.Close()
 
+	if query.Projected() {
+		return r.findProjection(log, session, query)
+	}
+
 	node := strings.ToLower(query.Node)
-	match, where, _, value := provider.TranslateQuery(query)
+	match, where, _, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
 
 	/**
 	p = (city:City)-[*0..1]->()
@@ -88,11 +106,19 @@ func (r *RepositoryInstance) FindAll(ctx context.Context, query *provider.Query)
 	}
 	defer session.Close()
 
+	if query.Projected() {
+		return r.findAllProjection(log, session, query)
+	}
+
 	node := strings.ToLower(query.Node)
-	match, where, order, value := provider.TranslateQuery(query)
+	match, where, order, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
 
 	/**
-	MATCH p = (city:City), (city)<-[*]-(province:Province)
+	MATCH p = (city:City), (city)<-[:CITIES]-(province:Province)
 		WHERE province.code = $`province.code`
 		WITH p, city
 		ORDER BY city.name ASC SKIP 0 LIMIT 25
@@ -130,3 +156,613 @@ func (r *RepositoryInstance) FindAll(ctx context.Context, query *provider.Query)
 
 	return results, nil
 }
+
+// findProjection returns only the selected properties and child nodes of the node
+func (r *RepositoryInstance) findProjection(log *logrus.Entry, session neo4j.Session, query *provider.Query) (*domain.Region, error) {
+	node := strings.ToLower(query.Node)
+	match, where, _, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
+
+	projection, err := provider.TranslateProjection(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate projection")
+		return nil, err
+	}
+
+	/**
+	MATCH (regency:Regency)
+		WHERE regency.code = $`regency.code`
+		WITH DISTINCT regency
+	RETURN regency {.id, .name, districts: [(regency)-[:DISTRICTS]->(district:District) | district {.id, .name}]} AS value
+	*/
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH DISTINCT %s
+			RETURN %s AS value`,
+		match, where, node, projection)
+
+	record, err := neo4j.Single(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Warnln("Failed run action to storage")
+		return nil, err
+	}
+
+	var region domain.Region
+	if err := provider.RecordUnmarshal(record.GetByIndex(0), &region); err != nil {
+		log.WithError(err).Errorln("Failed parse result to struct")
+		return nil, err
+	}
+
+	return &region, nil
+}
+
+// findAllProjection returns only the selected properties and child nodes of the nodes
+func (r *RepositoryInstance) findAllProjection(log *logrus.Entry, session neo4j.Session, query *provider.Query) ([]*domain.Region, error) {
+	node := strings.ToLower(query.Node)
+	match, where, order, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
+
+	projection, err := provider.TranslateProjection(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate projection")
+		return nil, err
+	}
+
+	/**
+	MATCH (city:City), (city)<-[:CITIES]-(province:Province)
+		WHERE province.code = $`province.code`
+		WITH DISTINCT city
+		ORDER BY city.name ASC SKIP 0 LIMIT 25
+		WITH city {.id, .name} AS value
+	RETURN COLLECT(value) AS value
+	*/
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH DISTINCT %s
+			%s
+			WITH %s AS value
+			RETURN COLLECT(value) AS value`,
+		match, where, node, order, projection)
+
+	records, err := neo4j.Collect(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return nil, err
+	}
+
+	var results []*domain.Region
+	if len(records) > 0 {
+		if err := provider.RecordUnmarshal(records[0].GetByIndex(0), &results); err != nil {
+			log.WithError(err).Errorln("Failed parse result to struct")
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+func (r *RepositoryInstance) Count(ctx context.Context, query *provider.Query) (int, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(r.Count).Pointer()).Name(),
+	})
+
+	session, err := r.driver.Session(neo4j.AccessModeRead)
+	if err != nil {
+		log.WithError(err).Errorln("Failed create new session")
+		return 0, err
+	}
+	defer session.Close()
+
+	node := strings.ToLower(query.Node)
+	match, where, _, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return 0, err
+	}
+
+	/**
+	MATCH (city:City), (city)<-[:CITIES]-(province:Province)
+		WHERE province.code = $`province.code`
+	RETURN COUNT(DISTINCT city) AS total
+	*/
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			RETURN COUNT(DISTINCT %s) AS total`, match, where, node)
+
+	record, err := neo4j.Single(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return 0, err
+	}
+
+	total, ok := record.GetByIndex(0).(int64)
+	if !ok {
+		log.WithField("total", record.GetByIndex(0)).Errorln("Failed parse result to number")
+		return 0, fmt.Errorf("invalid count result %v", record.GetByIndex(0))
+	}
+
+	return int(total), nil
+}
+
+// Aggregate counts the query node grouped by the group node, the group node must be matched by the query
+func (r *RepositoryInstance) Aggregate(ctx context.Context, query *provider.Query, group string) ([]*domain.RegionCount, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(r.Aggregate).Pointer()).Name(),
+	})
+
+	session, err := r.driver.Session(neo4j.AccessModeRead)
+	if err != nil {
+		log.WithError(err).Errorln("Failed create new session")
+		return nil, err
+	}
+	defer session.Close()
+
+	node := strings.ToLower(query.Node)
+	match, where, _, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
+
+	/**
+	MATCH (district:District), (district)<-[:CITIES|DISTRICTS|REGENCIES*2]-(province:Province), (district)<-[:DISTRICTS]-(regency:Regency)
+		WHERE province.code = $`province.code`
+		WITH regency AS key, COUNT(DISTINCT district) AS total
+		ORDER BY key.name ASC
+	RETURN COLLECT({group: properties(key), total: total}) AS value
+	*/
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH %s AS key, COUNT(DISTINCT %s) AS total
+			ORDER BY key.name ASC
+			RETURN COLLECT({group: properties(key), total: total}) AS value`, match, where, strings.ToLower(group), node)
+
+	record, err := neo4j.Single(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return nil, err
+	}
+
+	var results []*domain.RegionCount
+	if err := provider.RecordUnmarshal(record.GetByIndex(0), &results); err != nil {
+		log.WithError(err).Errorln("Failed parse result to struct")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// Ancestors finds the country and upper administrative divisions of every node match the query
+func (r *RepositoryInstance) Ancestors(ctx context.Context, query *provider.Query) ([]*domain.Ancestors, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(r.Ancestors).Pointer()).Name(),
+	})
+
+	session, err := r.driver.Session(neo4j.AccessModeRead)
+	if err != nil {
+		log.WithError(err).Errorln("Failed create new session")
+		return nil, err
+	}
+	defer session.Close()
+
+	node := strings.ToLower(query.Node)
+	match, where, _, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
+
+	/**
+	MATCH (village:Village)
+		WHERE village.id IN $`village.id`
+		OPTIONAL MATCH p = (root:Country)-[:CITIES|DISTRICTS|PROVINCES|REGENCIES|VILLAGES*4]->(village)
+		WITH village, root, [n IN nodes(p)[1..-1] | n {.*, level: labels(n)[0]}] AS path
+	RETURN COLLECT({id: village.id, country: properties(root), path: path}) AS value
+	*/
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			OPTIONAL MATCH p = (root:%s)-%s->(%s)
+			WITH %s, root, [n IN nodes(p)[1..-1] | n {.*, level: labels(n)[0]}] AS path
+			RETURN COLLECT({id: %s.id, country: properties(root), path: path}) AS value`,
+		match, where, domain.CountryNode, provider.RelationshipOf(domain.CountryNode, query.Node).Pattern(), node, node, node)
+
+	record, err := neo4j.Single(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return nil, err
+	}
+
+	var results []*domain.Ancestors
+	if err := provider.RecordUnmarshal(record.GetByIndex(0), &results); err != nil {
+		log.WithError(err).Errorln("Failed parse result to struct")
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// Children finds the nodes match the query with the direct child nodes of the child label
+func (r *RepositoryInstance) Children(ctx context.Context, query *provider.Query, child string) ([]*domain.Region, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(r.Children).Pointer()).Name(),
+	})
+
+	session, err := r.driver.Session(neo4j.AccessModeRead)
+	if err != nil {
+		log.WithError(err).Errorln("Failed create new session")
+		return nil, err
+	}
+	defer session.Close()
+
+	match, where, _, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
+
+	/**
+	MATCH p = (province:Province)-[:REGENCIES]->(:Regency)
+		WHERE province.id IN $`province.id`
+		WITH COLLECT(p) AS val
+		CALL apoc.convert.toTree(val) YIELD value
+	RETURN COLLECT(value) AS value
+	*/
+	filter := fmt.Sprintf(`MATCH p = %s%s(:%s)
+			%s
+			WITH COLLECT(p) AS val
+			CALL apoc.convert.toTree(val) YIELD value
+			RETURN COLLECT(value) AS value`, match, provider.RelationshipOf(query.Node, child).Step(), child, where)
+
+	records, err := neo4j.Collect(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return nil, err
+	}
+
+	var results []*domain.Region
+	if len(records) > 0 {
+		if err := provider.RecordUnmarshal(records[0].GetByIndex(0), &results); err != nil {
+			log.WithError(err).Errorln("Failed parse result to struct")
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// Search finds the administrative divisions matched by the full-text index of the name ordered by score,
+// level of the results is filled from the node label
+func (r *RepositoryInstance) Search(ctx context.Context, search *domain.Search) ([]*domain.Region, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(r.Search).Pointer()).Name(),
+	})
+
+	session, err := r.driver.Session(neo4j.AccessModeRead)
+	if err != nil {
+		log.WithError(err).Errorln("Failed create new session")
+		return nil, err
+	}
+	defer session.Close()
+
+	levels := search.Levels
+	if len(levels) < 1 {
+		levels = domain.RegionNodes
+	}
+
+	value := map[string]interface{}{
+		"text":   provider.FullTextQuery(search.Text, domain.NameAlternatives),
+		"levels": levels,
+		"limit":  search.Limit,
+	}
+
+	var match, where string
+	if search.Country != nil && (len(search.Country.Filters) > 0 || len(search.Country.Outgoings) > 0) {
+		var relationship *provider.Relationship
+		for _, level := range levels {
+			relationship = relationship.Merge(provider.RelationshipOf(domain.CountryNode, level))
+		}
+
+		q, f, err := provider.TranslateFilter(search.Country, nil, value)
+		if err != nil {
+			log.WithError(err).Warnln("Failed translate query")
+			return nil, err
+		}
+
+		nodes := []string{fmt.Sprintf("(country:%s)-%s->(node)", domain.CountryNode, relationship.Pattern())}
+		// Node related to the country, e.g. currency, is matched from the country
+		for _, out := range search.Country.Outgoings {
+			q, f, err = provider.TranslateFilter(out, q, f)
+			if err != nil {
+				log.WithError(err).Warnln("Failed translate query")
+				return nil, err
+			}
+
+			nodes = append(nodes, fmt.Sprintf("(country)-%s->(%s:%s)", out.Relationship.Pattern(), strings.ToLower(out.Node), out.Node))
+		}
+
+		value = f
+		match = fmt.Sprintf("MATCH %s", strings.Join(nodes, ", "))
+		if len(q) > 0 {
+			where = fmt.Sprintf("WHERE %s", strings.Join(q, " AND "))
+		}
+	}
+
+	/**
+	CALL db.index.fulltext.queryNodes("region_name_fulltext", $text) YIELD node, score
+		WITH node, score WHERE head(labels(node)) IN $levels
+		MATCH (country:Country)-[:CITIES|DISTRICTS|PROVINCES|REGENCIES|VILLAGES*1..4]->(node)
+		WHERE country.ISO3166Alpha2 = $`country.ISO3166Alpha2`
+		WITH DISTINCT node, score ORDER BY score DESC LIMIT $limit
+	RETURN COLLECT(node {.id, .name, .code, .location, .createdAt, .updatedAt, level: head(labels(node))}) AS value
+	*/
+	filter := fmt.Sprintf(`CALL db.index.fulltext.queryNodes("%s", $text) YIELD node, score
+			WITH node, score WHERE head(labels(node)) IN $levels
+			%s
+			%s
+			WITH DISTINCT node, score ORDER BY score DESC LIMIT $limit
+			RETURN COLLECT(node {.id, .name, .code, .location, .createdAt, .updatedAt, level: head(labels(node))}) AS value`,
+		domain.RegionFullTextIndex, match, where)
+
+	records, err := neo4j.Collect(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return nil, err
+	}
+
+	var results []*domain.Region
+	if len(records) > 0 {
+		if err := provider.RecordUnmarshal(records[0].GetByIndex(0), &results); err != nil {
+			log.WithError(err).Errorln("Failed parse result to struct")
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// SearchAll runs the full-text searches in one query, results are in the order of the searches,
+// country of the searches isn't supported
+func (r *RepositoryInstance) SearchAll(ctx context.Context, searches []*domain.Search) ([][]*domain.Region, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(r.SearchAll).Pointer()).Name(),
+	})
+
+	params := make([]interface{}, 0, len(searches))
+	for i, search := range searches {
+		if search.Country != nil {
+			return nil, fmt.Errorf("country of search %d is not supported in batch", i)
+		}
+
+		levels := search.Levels
+		if len(levels) < 1 {
+			levels = domain.RegionNodes
+		}
+
+		params = append(params, map[string]interface{}{
+			"index":  i,
+			"text":   provider.FullTextQuery(search.Text, domain.NameAlternatives),
+			"levels": levels,
+			"limit":  search.Limit,
+		})
+	}
+
+	session, err := r.driver.Session(neo4j.AccessModeRead)
+	if err != nil {
+		log.WithError(err).Errorln("Failed create new session")
+		return nil, err
+	}
+	defer session.Close()
+
+	/**
+	UNWIND $searches AS search
+		CALL db.index.fulltext.queryNodes("region_name_fulltext", search.text) YIELD node, score
+		WITH search, node, score WHERE head(labels(node)) IN search.levels
+		WITH search, node, score ORDER BY score DESC
+		WITH search, COLLECT(node {.id, .name, .code, .location, .createdAt, .updatedAt, level: head(labels(node))})[..search.limit] AS hits
+	RETURN COLLECT({index: search.index, hits: hits}) AS value
+	*/
+	filter := fmt.Sprintf(`UNWIND $searches AS search
+			CALL db.index.fulltext.queryNodes("%s", search.text) YIELD node, score
+			WITH search, node, score WHERE head(labels(node)) IN search.levels
+			WITH search, node, score ORDER BY score DESC
+			WITH search, COLLECT(node {.id, .name, .code, .location, .createdAt, .updatedAt, level: head(labels(node))})[..search.limit] AS hits
+			RETURN COLLECT({index: search.index, hits: hits}) AS value`, domain.RegionFullTextIndex)
+
+	record, err := neo4j.Single(session.Run(filter, map[string]interface{}{"searches": params}))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return nil, err
+	}
+
+	var hits []struct {
+		Index int              `json:"index"`
+		Hits  []*domain.Region `json:"hits"`
+	}
+	if err := provider.RecordUnmarshal(record.GetByIndex(0), &hits); err != nil {
+		log.WithError(err).Errorln("Failed parse result to struct")
+		return nil, err
+	}
+
+	// Search without match has no row
+	results := make([][]*domain.Region, len(searches))
+	for _, hit := range hits {
+		if hit.Index >= 0 && hit.Index < len(results) {
+			results[hit.Index] = hit.Hits
+		}
+	}
+
+	return results, nil
+}
+
+// Boundaries finds the boundary geometries of the nodes match the query, nodes without boundary are skipped
+func (r *RepositoryInstance) Boundaries(ctx context.Context, query *provider.Query) ([]*domain.Boundary, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(r.Boundaries).Pointer()).Name(),
+	})
+
+	session, err := r.driver.Session(neo4j.AccessModeRead)
+	if err != nil {
+		log.WithError(err).Errorln("Failed create new session")
+		return nil, err
+	}
+	defer session.Close()
+
+	node := strings.ToLower(query.Node)
+	match, where, order, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
+
+	/**
+	MATCH (district:District)
+		WHERE district.code STARTS WITH $`district.code.StartsWith`
+		MATCH (district)-[:BOUNDARY]->(boundary:Boundary)
+		WITH district, boundary
+		ORDER BY district.code ASC
+	RETURN COLLECT({id: district.id, code: district.code, name: district.name, level: head(labels(district)), geometry: boundary.geometry}) AS value
+	*/
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			MATCH (%s)-[:BOUNDARY]->(boundary:%s)
+			WITH %s, boundary
+			%s
+			RETURN COLLECT({id: %s.id, code: %s.code, name: %s.name, level: head(labels(%s)), geometry: boundary.geometry}) AS value`,
+		match, where, node, domain.BoundaryNode, node, order, node, node, node, node)
+
+	records, err := neo4j.Collect(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return nil, err
+	}
+
+	var results []*domain.Boundary
+	if len(records) > 0 {
+		if err := provider.RecordUnmarshal(records[0].GetByIndex(0), &results); err != nil {
+			log.WithError(err).Errorln("Failed parse result to struct")
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// BoundingBoxes finds the bounding boxes of the boundaries of the nodes match the query without the geometries
+func (r *RepositoryInstance) BoundingBoxes(ctx context.Context, query *provider.Query) ([]*domain.Boundary, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(r.BoundingBoxes).Pointer()).Name(),
+	})
+
+	session, err := r.driver.Session(neo4j.AccessModeRead)
+	if err != nil {
+		log.WithError(err).Errorln("Failed create new session")
+		return nil, err
+	}
+	defer session.Close()
+
+	node := strings.ToLower(query.Node)
+	match, where, _, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
+
+	/**
+	MATCH (village:Village)
+		MATCH (village)-[:BOUNDARY]->(boundary:Boundary)
+		WHERE boundary.bbox IS NOT NULL
+	RETURN COLLECT({id: village.id, code: village.code, level: head(labels(village)), bbox: boundary.bbox}) AS value
+	*/
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			MATCH (%s)-[:BOUNDARY]->(boundary:%s)
+			WHERE boundary.bbox IS NOT NULL
+			RETURN COLLECT({id: %s.id, code: %s.code, level: head(labels(%s)), bbox: boundary.bbox}) AS value`,
+		match, where, node, domain.BoundaryNode, node, node, node)
+
+	records, err := neo4j.Collect(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return nil, err
+	}
+
+	var results []*domain.Boundary
+	if len(records) > 0 {
+		if err := provider.RecordUnmarshal(records[0].GetByIndex(0), &results); err != nil {
+			log.WithError(err).Errorln("Failed parse result to struct")
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// Neighbours finds the nodes match the query with the nodes of the same level sharing a border ordered by name
+func (r *RepositoryInstance) Neighbours(ctx context.Context, query *provider.Query) ([]*domain.Region, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(r.Neighbours).Pointer()).Name(),
+	})
+
+	session, err := r.driver.Session(neo4j.AccessModeRead)
+	if err != nil {
+		log.WithError(err).Errorln("Failed create new session")
+		return nil, err
+	}
+	defer session.Close()
+
+	node := strings.ToLower(query.Node)
+	match, where, _, value, err := provider.TranslateQuery(query)
+	if err != nil {
+		log.WithError(err).Warnln("Failed translate query")
+		return nil, err
+	}
+
+	/**
+	MATCH (regency:Regency)
+		WHERE regency.id IN $`regency.id`
+		WITH DISTINCT regency
+	RETURN COLLECT(regency {.id, neighbours: [(regency)-[:BORDERS]-(neighbour:Regency) | neighbour {.*}]}) AS value
+	*/
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH DISTINCT %s
+			RETURN COLLECT(%s {.id, neighbours: [(%s)-[:%s]-(neighbour:%s) | neighbour {.*}]}) AS value`,
+		match, where, node, node, node, domain.BordersRelationship, query.Node)
+
+	records, err := neo4j.Collect(session.Run(filter, value))
+	if err != nil {
+		log.WithError(err).Errorln("Failed run action to storage")
+		return nil, err
+	}
+
+	var results []*domain.Region
+	if len(records) > 0 {
+		if err := provider.RecordUnmarshal(records[0].GetByIndex(0), &results); err != nil {
+			log.WithError(err).Errorln("Failed parse result to struct")
+			return nil, err
+		}
+	}
+
+	for _, result := range results {
+		sort.Slice(result.Neighbours, func(i, j int) bool {
+			return result.Neighbours[i].Name < result.Neighbours[j].Name
+		})
+	}
+
+	return results, nil
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/neo4j/neo4j-go-driver/neo4j"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -54,7 +55,7 @@ func (r *RepositorySuite) Test_Find_Error() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, _, value := provider.TranslateQuery(query)
+	match, where, _, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s-[*0..1]->()
 			%s
@@ -72,12 +73,26 @@ func (r *RepositorySuite) Test_Find_Error() {
 	assert.Error(r.T(), err)
 }
 
+func (r *RepositorySuite) Test_Find_ErrorTranslate() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery(domain.ProvinceNode).Filter("name} RETURN 1 //", provider.Equal, "Jawa Barat")
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Find(context.Background(), query)
+
+	assert.Nil(r.T(), res)
+	assert.IsType(r.T(), &provider.PropertyError{}, err)
+	r.provider.AssertNotCalled(r.T(), "Run", mock.Anything, mock.Anything)
+}
+
 func (r *RepositorySuite) Test_Find_ErrorUnmarshal() {
 	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, _, value := provider.TranslateQuery(query)
+	match, where, _, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s-[*0..1]->()
 			%s
@@ -104,7 +119,7 @@ func (r *RepositorySuite) Test_Find_Success() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, _, value := provider.TranslateQuery(query)
+	match, where, _, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s-[*0..1]->()
 			%s
@@ -150,7 +165,7 @@ func (r *RepositorySuite) Test_FindAll_Error() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, order, value := provider.TranslateQuery(query)
+	match, where, order, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s
 			%s
@@ -174,7 +189,7 @@ func (r *RepositorySuite) Test_FindAll_ErrorUnmarshal() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, order, value := provider.TranslateQuery(query)
+	match, where, order, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s
 			%s
@@ -202,7 +217,7 @@ func (r *RepositorySuite) Test_FindAll_Empty() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, order, value := provider.TranslateQuery(query)
+	match, where, order, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s
 			%s
@@ -233,7 +248,7 @@ func (r *RepositorySuite) Test_FindAll_Success() {
 	r.provider.On("Close").Return(nil)
 
 	query := provider.NewQuery("Test")
-	match, where, order, value := provider.TranslateQuery(query)
+	match, where, order, value, _ := provider.TranslateQuery(query)
 
 	filter := fmt.Sprintf(`MATCH p = %s
 			%s
@@ -286,3 +301,738 @@ func (r *RepositorySuite) Test_FindAll_Success() {
 	assert.NotEmpty(r.T(), res)
 	assert.NoError(r.T(), err)
 }
+
+func (r *RepositorySuite) Test_Count_ErrorSession() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+
+	res, err := repo.Count(context.Background(), &provider.Query{})
+
+	assert.Zero(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Count_Error() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			RETURN COUNT(DISTINCT %s) AS total`, match, where, "test")
+
+	r.provider.On("Run", filter, value).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Count(context.Background(), query)
+
+	assert.Zero(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Count_ErrorParse() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			RETURN COUNT(DISTINCT %s) AS total`, match, where, "test")
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return("<-")
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Count(context.Background(), query)
+
+	assert.Zero(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Count_Success() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			RETURN COUNT(DISTINCT %s) AS total`, match, where, "test")
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return(int64(514))
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Count(context.Background(), query)
+
+	assert.Equal(r.T(), 514, res)
+	assert.NoError(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Aggregate_ErrorSession() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+
+	res, err := repo.Aggregate(context.Background(), &provider.Query{}, "Group")
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Aggregate_Error() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH %s AS key, COUNT(DISTINCT %s) AS total
+			ORDER BY key.name ASC
+			RETURN COLLECT({group: properties(key), total: total}) AS value`, match, where, "group", "test")
+
+	r.provider.On("Run", filter, value).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Aggregate(context.Background(), query, "Group")
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Aggregate_ErrorUnmarshal() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH %s AS key, COUNT(DISTINCT %s) AS total
+			ORDER BY key.name ASC
+			RETURN COLLECT({group: properties(key), total: total}) AS value`, match, where, "group", "test")
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return("<-")
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Aggregate(context.Background(), query, "Group")
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Aggregate_Success() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH %s AS key, COUNT(DISTINCT %s) AS total
+			ORDER BY key.name ASC
+			RETURN COLLECT({group: properties(key), total: total}) AS value`, match, where, "group", "test")
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return([]map[string]interface{}{
+		{"group": map[string]interface{}{"name": "Fukuoka", "code": "40"}, "total": int64(60)},
+		{"group": map[string]interface{}{"name": "Kyoto", "code": "26"}, "total": int64(26)},
+	})
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Aggregate(context.Background(), query, "Group")
+
+	assert.Len(r.T(), res, 2)
+	assert.Equal(r.T(), "Fukuoka", res[0].Group.Name)
+	assert.Equal(r.T(), 60, res[0].Total)
+	assert.NoError(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Ancestors_ErrorSession() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+
+	res, err := repo.Ancestors(context.Background(), &provider.Query{})
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Ancestors_Error() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			OPTIONAL MATCH p = (root:Country)-[*]->(test)
+			WITH test, root, [n IN nodes(p)[1..-1] | n {.*, level: labels(n)[0]}] AS path
+			RETURN COLLECT({id: test.id, country: properties(root), path: path}) AS value`, match, where)
+
+	r.provider.On("Run", filter, value).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Ancestors(context.Background(), query)
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Ancestors_Success() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			OPTIONAL MATCH p = (root:Country)-[*]->(test)
+			WITH test, root, [n IN nodes(p)[1..-1] | n {.*, level: labels(n)[0]}] AS path
+			RETURN COLLECT({id: test.id, country: properties(root), path: path}) AS value`, match, where)
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return([]map[string]interface{}{
+		{
+			"id":      "e81f509f-38ec-42e8-9a1c-8e527977e526",
+			"country": map[string]interface{}{"name": "Japan"},
+			"path": []map[string]interface{}{
+				{"name": "Fukuoka", "code": "40", "level": "Province"},
+			},
+		},
+	})
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Ancestors(context.Background(), query)
+
+	assert.Len(r.T(), res, 1)
+	assert.Equal(r.T(), "Japan", res[0].Country.Name)
+	assert.Equal(r.T(), domain.ProvinceNode, res[0].Path[0].Level)
+	assert.NoError(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Children_ErrorSession() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+
+	res, err := repo.Children(context.Background(), &provider.Query{}, domain.RegencyNode)
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Children_Error() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH p = %s-->(:Regency)
+			%s
+			WITH COLLECT(p) AS val
+			CALL apoc.convert.toTree(val) YIELD value
+			RETURN COLLECT(value) AS value`, match, where)
+
+	r.provider.On("Run", filter, value).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Children(context.Background(), query, domain.RegencyNode)
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Children_Success() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Test")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH p = %s-->(:Regency)
+			%s
+			WITH COLLECT(p) AS val
+			CALL apoc.convert.toTree(val) YIELD value
+			RETURN COLLECT(value) AS value`, match, where)
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return([]map[string]interface{}{
+		{
+			"id":   "e81f509f-38ec-42e8-9a1c-8e527977e526",
+			"name": "Jawa Barat",
+			"regencies": []map[string]interface{}{
+				{"name": "Bandung", "code": "32.04"},
+				{"name": "Bogor", "code": "32.01"},
+			},
+		},
+	})
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Children(context.Background(), query, domain.RegencyNode)
+
+	assert.Len(r.T(), res, 1)
+	assert.Len(r.T(), res[0].Children(domain.RegencyNode), 2)
+	assert.NoError(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Find_Projection() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("Regency")
+	query.Filter("code", provider.Equal, "32.04")
+	query.Select("name")
+	query.ExpansionOf("districts", domain.DistrictNode).Select("name")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH DISTINCT regency
+			RETURN %s AS value`, match, where,
+		"regency {.id, .name, districts: [(regency)-[:DISTRICTS]->(district:District) | district {.id, .name}]}")
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return(map[string]interface{}{
+		"id":        "e81f509f-38ec-42e8-9a1c-8e527977e526",
+		"name":      "Bandung",
+		"districts": []interface{}{},
+	})
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Find(context.Background(), query)
+
+	assert.Equal(r.T(), "Bandung", res.Name)
+	assert.NotNil(r.T(), res.Children(domain.DistrictNode))
+	assert.Empty(r.T(), res.Children(domain.DistrictNode))
+	assert.NoError(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_FindAll_ProjectionError() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery("City")
+	query.Ordering("name", provider.Ascending)
+	query.Slice(0, 25)
+	query.Select("code")
+	match, where, order, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH DISTINCT city
+			%s
+			WITH %s AS value
+			RETURN COLLECT(value) AS value`, match, where, order, "city {.id, .code, .name}")
+
+	r.provider.On("Run", filter, value).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.FindAll(context.Background(), query)
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Search_ErrorSession() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+
+	res, err := repo.Search(context.Background(), &domain.Search{Text: "bandung"})
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Search_Error() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	filter := `CALL db.index.fulltext.queryNodes("region_name_fulltext", $text) YIELD node, score
+			WITH node, score WHERE head(labels(node)) IN $levels
+			
+			
+			WITH DISTINCT node, score ORDER BY score DESC LIMIT $limit
+			RETURN COLLECT(node {.id, .name, .code, .location, .createdAt, .updatedAt, level: head(labels(node))}) AS value`
+	value := map[string]interface{}{
+		"text":   "+(bandung^2 OR bandung* OR bandung~)",
+		"levels": domain.RegionNodes,
+		"limit":  10,
+	}
+
+	r.provider.On("Run", filter, value).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Search(context.Background(), &domain.Search{Text: "bandung", Limit: 10})
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Search_Success() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	filter := `CALL db.index.fulltext.queryNodes("region_name_fulltext", $text) YIELD node, score
+			WITH node, score WHERE head(labels(node)) IN $levels
+			MATCH (country:Country)-[:CITIES|PROVINCES|REGENCIES*2]->(node)
+			WHERE country.ISO3166Alpha2 = $` + "`country.ISO3166Alpha2`" + `
+			WITH DISTINCT node, score ORDER BY score DESC LIMIT $limit
+			RETURN COLLECT(node {.id, .name, .code, .location, .createdAt, .updatedAt, level: head(labels(node))}) AS value`
+	value := map[string]interface{}{
+		"text":                  "+(kab^2 OR kab*) +(bandung^2 OR bandung* OR bandung~)",
+		"levels":                []string{domain.CityNode, domain.RegencyNode},
+		"limit":                 10,
+		"country.ISO3166Alpha2": "ID",
+	}
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return([]interface{}{
+		map[string]interface{}{"id": "e81f509f-38ec-42e8-9a1c-8e527977e526", "name": "Kabupaten Bandung", "code": "32.04", "level": "Regency"},
+		map[string]interface{}{"id": "9b2f6c4e-6f0b-4a44-8d1f-0f3d4a1c2b7e", "name": "Kota Bandung", "code": "32.73", "level": "City"},
+	})
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Search(context.Background(), &domain.Search{
+		Text:    "Kab. Bandung",
+		Levels:  []string{domain.CityNode, domain.RegencyNode},
+		Country: provider.NewQuery(domain.CountryNode).Filter("ISO3166Alpha2", provider.Equal, "ID"),
+		Limit:   10,
+	})
+
+	assert.Len(r.T(), res, 2)
+	assert.Equal(r.T(), domain.RegencyNode, res[0].Level)
+	assert.NoError(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Search_Currency() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	filter := `CALL db.index.fulltext.queryNodes("region_name_fulltext", $text) YIELD node, score
+			WITH node, score WHERE head(labels(node)) IN $levels
+			MATCH (country:Country)-[:PROVINCES]->(node), (country)-[:CURRENCIES]->(currency:Currency)
+			WHERE currency.ISO4217Alphabetic = $` + "`currency.ISO4217Alphabetic`" + `
+			WITH DISTINCT node, score ORDER BY score DESC LIMIT $limit
+			RETURN COLLECT(node {.id, .name, .code, .location, .createdAt, .updatedAt, level: head(labels(node))}) AS value`
+	value := map[string]interface{}{
+		"text":                       "+(jawa^2 OR jawa* OR jawa~)",
+		"levels":                     []string{domain.ProvinceNode},
+		"limit":                      10,
+		"currency.ISO4217Alphabetic": "IDR",
+	}
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return([]interface{}{
+		map[string]interface{}{"id": "e81f509f-38ec-42e8-9a1c-8e527977e526", "name": "Jawa Barat", "code": "32", "level": "Province"},
+	})
+
+	country := provider.NewQuery(domain.CountryNode)
+	country.Outgoing(provider.NewQuery(domain.CurrencyNode).Filter("ISO4217Alphabetic", provider.Equal, "IDR"))
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Search(context.Background(), &domain.Search{
+		Text:    "jawa",
+		Levels:  []string{domain.ProvinceNode},
+		Country: country,
+		Limit:   10,
+	})
+
+	assert.NoError(r.T(), err)
+	assert.Len(r.T(), res, 1)
+}
+
+func (r *RepositorySuite) Test_SearchAll_Country() {
+	repo := region.NewRepository(r.provider)
+
+	res, err := repo.SearchAll(context.Background(), []*domain.Search{
+		{Text: "bandung", Country: provider.NewQuery(domain.CountryNode).Filter("ISO3166Alpha2", provider.Equal, "ID")},
+	})
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+	r.provider.AssertNotCalled(r.T(), "Session", mock.Anything, mock.Anything)
+}
+
+func (r *RepositorySuite) Test_SearchAll_Success() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	filter := `UNWIND $searches AS search
+			CALL db.index.fulltext.queryNodes("region_name_fulltext", search.text) YIELD node, score
+			WITH search, node, score WHERE head(labels(node)) IN search.levels
+			WITH search, node, score ORDER BY score DESC
+			WITH search, COLLECT(node {.id, .name, .code, .location, .createdAt, .updatedAt, level: head(labels(node))})[..search.limit] AS hits
+			RETURN COLLECT({index: search.index, hits: hits}) AS value`
+	value := map[string]interface{}{
+		"searches": []interface{}{
+			map[string]interface{}{
+				"index":  0,
+				"text":   "+(coblong^2 OR coblong* OR coblong~)",
+				"levels": []string{domain.DistrictNode},
+				"limit":  10,
+			},
+			map[string]interface{}{
+				"index":  1,
+				"text":   "+(atlantis^2 OR atlantis* OR atlantis~)",
+				"levels": domain.RegionNodes,
+				"limit":  10,
+			},
+		},
+	}
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return([]interface{}{
+		map[string]interface{}{
+			"index": int64(0),
+			"hits": []interface{}{
+				map[string]interface{}{"id": "e81f509f-38ec-42e8-9a1c-8e527977e526", "name": "Coblong", "code": "32.73.02", "level": "District"},
+			},
+		},
+	})
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.SearchAll(context.Background(), []*domain.Search{
+		{Text: "coblong", Levels: []string{domain.DistrictNode}, Limit: 10},
+		{Text: "atlantis", Limit: 10},
+	})
+
+	assert.NoError(r.T(), err)
+	assert.Len(r.T(), res, 2)
+	assert.Equal(r.T(), "32.73.02", res[0][0].Code)
+	assert.Empty(r.T(), res[1])
+}
+
+func (r *RepositorySuite) Test_Boundaries_ErrorSession() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+
+	res, err := repo.Boundaries(context.Background(), &provider.Query{})
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Boundaries_Error() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery(domain.ProvinceNode)
+	match, where, order, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			MATCH (province)-[:BOUNDARY]->(boundary:Boundary)
+			WITH province, boundary
+			%s
+			RETURN COLLECT({id: province.id, code: province.code, name: province.name, level: head(labels(province)), geometry: boundary.geometry}) AS value`,
+		match, where, order)
+
+	r.provider.On("Run", filter, value).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Boundaries(context.Background(), query)
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Boundaries_Success() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery(domain.DistrictNode).
+		Filter("code", provider.StartsWith, "32.73.").
+		Ordering("code", provider.Ascending)
+
+	filter := `MATCH (district:District)
+			WHERE district.code STARTS WITH $` + "`district.code.StartsWith`" + `
+			MATCH (district)-[:BOUNDARY]->(boundary:Boundary)
+			WITH district, boundary
+			ORDER BY district.code ASC
+			RETURN COLLECT({id: district.id, code: district.code, name: district.name, level: head(labels(district)), geometry: boundary.geometry}) AS value`
+
+	r.provider.On("Run", filter, map[string]interface{}{"district.code.StartsWith": "32.73."}).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return([]interface{}{
+		map[string]interface{}{
+			"id":       "e81f509f-38ec-42e8-9a1c-8e527977e526",
+			"code":     "32.73.02",
+			"name":     "Coblong",
+			"level":    "District",
+			"geometry": `{"type":"Polygon","coordinates":[[[107.6,-6.9],[107.7,-6.9],[107.7,-6.8],[107.6,-6.9]]]}`,
+		},
+	})
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Boundaries(context.Background(), query)
+
+	assert.Len(r.T(), res, 1)
+	assert.Equal(r.T(), "32.73.02", res[0].Code)
+	assert.NoError(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_BoundingBoxes_Error() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery(domain.VillageNode)
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			MATCH (village)-[:BOUNDARY]->(boundary:Boundary)
+			WHERE boundary.bbox IS NOT NULL
+			RETURN COLLECT({id: village.id, code: village.code, level: head(labels(village)), bbox: boundary.bbox}) AS value`,
+		match, where)
+
+	r.provider.On("Run", filter, value).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.BoundingBoxes(context.Background(), query)
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_BoundingBoxes_Success() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery(domain.VillageNode)
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			MATCH (village)-[:BOUNDARY]->(boundary:Boundary)
+			WHERE boundary.bbox IS NOT NULL
+			RETURN COLLECT({id: village.id, code: village.code, level: head(labels(village)), bbox: boundary.bbox}) AS value`,
+		match, where)
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return([]interface{}{
+		map[string]interface{}{
+			"id":    "e81f509f-38ec-42e8-9a1c-8e527977e526",
+			"code":  "32.73.02.1001",
+			"level": "Village",
+			"bbox":  []interface{}{107.6, -6.9, 107.7, -6.8},
+		},
+	})
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.BoundingBoxes(context.Background(), query)
+
+	assert.Len(r.T(), res, 1)
+	assert.Equal(r.T(), []float64{107.6, -6.9, 107.7, -6.8}, res[0].BBox)
+	assert.NoError(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Neighbours_Error() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery(domain.RegencyNode)
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH DISTINCT regency
+			RETURN COLLECT(regency {.id, neighbours: [(regency)-[:BORDERS]-(neighbour:Regency) | neighbour {.*}]}) AS value`,
+		match, where)
+
+	r.provider.On("Run", filter, value).Return(r.provider, assert.AnError)
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Neighbours(context.Background(), query)
+
+	assert.Nil(r.T(), res)
+	assert.Error(r.T(), err)
+}
+
+func (r *RepositorySuite) Test_Neighbours_Success() {
+	r.provider.On("Session", neo4j.AccessModeRead, []string(nil)).Return(r.provider, nil)
+	r.provider.On("Close").Return(nil)
+
+	query := provider.NewQuery(domain.RegencyNode).Filter("id", provider.In, "e81f509f-38ec-42e8-9a1c-8e527977e526")
+	match, where, _, value, _ := provider.TranslateQuery(query)
+
+	filter := fmt.Sprintf(`MATCH %s
+			%s
+			WITH DISTINCT regency
+			RETURN COLLECT(regency {.id, neighbours: [(regency)-[:BORDERS]-(neighbour:Regency) | neighbour {.*}]}) AS value`,
+		match, where)
+
+	r.provider.On("Run", filter, value).Return(r.provider, nil)
+	r.provider.On("Next").Return()
+	r.provider.On("Record").Return(r.record, nil)
+	r.provider.On("Err").Return(nil)
+	r.record.On("GetByIndex", 0).Return([]interface{}{
+		map[string]interface{}{
+			"id": "e81f509f-38ec-42e8-9a1c-8e527977e526",
+			"neighbours": []interface{}{
+				map[string]interface{}{"code": "32.17", "name": "Kabupaten Bandung Barat"},
+				map[string]interface{}{"code": "32.04", "name": "Kabupaten Bandung"},
+			},
+		},
+	})
+
+	repo := region.NewRepository(r.provider)
+	res, err := repo.Neighbours(context.Background(), query)
+
+	assert.NoError(r.T(), err)
+	assert.Len(r.T(), res, 1)
+	assert.Equal(r.T(), "32.04", res[0].Neighbours[0].Code)
+	assert.Equal(r.T(), "32.17", res[0].Neighbours[1].Code)
+}
@@ -22,3 +22,48 @@ func (m *MockRepository) FindAll(ctx context.Context, query *provider.Query) ([]
 	args := m.Called(ctx, query)
 	return args.Get(0).([]*domain.Region), args.Error(1)
 }
+
+func (m *MockRepository) Count(ctx context.Context, query *provider.Query) (int, error) {
+	args := m.Called(ctx, query)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRepository) Aggregate(ctx context.Context, query *provider.Query, group string) ([]*domain.RegionCount, error) {
+	args := m.Called(ctx, query, group)
+	return args.Get(0).([]*domain.RegionCount), args.Error(1)
+}
+
+func (m *MockRepository) Ancestors(ctx context.Context, query *provider.Query) ([]*domain.Ancestors, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*domain.Ancestors), args.Error(1)
+}
+
+func (m *MockRepository) Children(ctx context.Context, query *provider.Query, child string) ([]*domain.Region, error) {
+	args := m.Called(ctx, query, child)
+	return args.Get(0).([]*domain.Region), args.Error(1)
+}
+
+func (m *MockRepository) Search(ctx context.Context, search *domain.Search) ([]*domain.Region, error) {
+	args := m.Called(ctx, search)
+	return args.Get(0).([]*domain.Region), args.Error(1)
+}
+
+func (m *MockRepository) SearchAll(ctx context.Context, searches []*domain.Search) ([][]*domain.Region, error) {
+	args := m.Called(ctx, searches)
+	return args.Get(0).([][]*domain.Region), args.Error(1)
+}
+
+func (m *MockRepository) Boundaries(ctx context.Context, query *provider.Query) ([]*domain.Boundary, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*domain.Boundary), args.Error(1)
+}
+
+func (m *MockRepository) BoundingBoxes(ctx context.Context, query *provider.Query) ([]*domain.Boundary, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*domain.Boundary), args.Error(1)
+}
+
+func (m *MockRepository) Neighbours(ctx context.Context, query *provider.Query) ([]*domain.Region, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*domain.Region), args.Error(1)
+}
@@ -0,0 +1,144 @@
+package region
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/dynastymasra/cartographer/domain"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+
+	"github.com/dynastymasra/cookbook"
+	"github.com/sirupsen/logrus"
+)
+
+// Validator checks the supplied codes of the administrative divisions against the hierarchy in the graph
+type Validator struct {
+	repo Repository
+}
+
+func NewValidator(repo Repository) *Validator {
+	return &Validator{repo: repo}
+}
+
+// Validate checks every code exists in its level, and every level belongs to the nearest supplied upper level,
+// e.g. district is checked against regency or city, or against province when both are not supplied.
+// Empty codes are not supplied.
+func (v *Validator) Validate(ctx context.Context, supplied map[string]string) (*domain.RegionValidation, error) {
+	log := logrus.WithFields(logrus.Fields{
+		cookbook.RequestID: ctx.Value(cookbook.RequestID),
+		"package":          runtime.FuncForPC(reflect.ValueOf(v.Validate).Pointer()).Name(),
+	})
+
+	codes := make(map[string]string, len(supplied))
+	for level, code := range supplied {
+		if len(strings.TrimSpace(code)) > 0 {
+			codes[level] = code
+		}
+	}
+
+	validation := &domain.RegionValidation{Valid: true}
+	paths := make(map[string][]*domain.Region)
+
+	for _, level := range domain.RegionNodes {
+		code, ok := codes[level]
+		if !ok {
+			continue
+		}
+
+		query := provider.NewQuery(level).Filter("code", provider.Equal, code)
+
+		results, err := v.repo.Ancestors(ctx, query)
+		if err != nil {
+			log.WithField("query", cookbook.Stringify(query)).WithError(err).Errorln("Failed find ancestors from storage")
+			return nil, err
+		}
+
+		check := &domain.RegionCheck{
+			Level:  level,
+			Code:   code,
+			Exists: len(results) > 0,
+		}
+		if check.Exists {
+			paths[level] = results[0].Path
+		}
+
+		validation.Valid = validation.Valid && check.Exists
+		validation.Regions = append(validation.Regions, check)
+	}
+
+	for _, child := range domain.RegionNodes {
+		if _, ok := codes[child]; !ok {
+			continue
+		}
+
+		for _, parent := range actualParents(nearestParents(child, codes), paths[child]) {
+			link := &domain.RegionLink{
+				Parent:     parent,
+				ParentCode: codes[parent],
+				Child:      child,
+				ChildCode:  codes[child],
+			}
+
+			for _, region := range paths[child] {
+				if region.Level == parent {
+					link.ActualParentCode = region.Code
+				}
+			}
+			link.Valid = len(link.ActualParentCode) > 0 && link.ActualParentCode == link.ParentCode
+
+			validation.Valid = validation.Valid && link.Valid
+			validation.Links = append(validation.Links, link)
+		}
+	}
+
+	return validation, nil
+}
+
+// nearestParents returns the supplied upper levels with the fewest hops to the child
+func nearestParents(child string, codes map[string]string) []string {
+	var parents []string
+	nearest := 0
+
+	for _, parent := range domain.RegionNodes {
+		if _, ok := codes[parent]; !ok {
+			continue
+		}
+
+		relationship := provider.RelationshipOf(parent, child)
+		if relationship == nil {
+			continue
+		}
+
+		switch {
+		case nearest == 0 || relationship.Min < nearest:
+			parents = []string{parent}
+			nearest = relationship.Min
+		case relationship.Min == nearest:
+			parents = append(parents, parent)
+		}
+	}
+
+	return parents
+}
+
+// actualParents returns the nearest parents found in the path of the child, nearest parents are alternatives,
+// e.g. district belongs to either city or regency, so only the one the child belongs to is checked.
+// Every nearest parent is checked when none is in the path.
+func actualParents(parents []string, path []*domain.Region) []string {
+	var actual []string
+	for _, parent := range parents {
+		for _, region := range path {
+			if region.Level == parent {
+				actual = append(actual, parent)
+				break
+			}
+		}
+	}
+
+	if len(actual) < 1 {
+		return parents
+	}
+	return actual
+}
@@ -0,0 +1,191 @@
+package region_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dynastymasra/cartographer/config"
+	"github.com/dynastymasra/cartographer/domain"
+	"github.com/dynastymasra/cartographer/infrastructure/provider"
+	"github.com/dynastymasra/cartographer/region"
+	"github.com/dynastymasra/cartographer/region/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ValidationSuite struct {
+	suite.Suite
+	repo *test.MockRepository
+}
+
+func Test_ValidationSuite(t *testing.T) {
+	suite.Run(t, new(ValidationSuite))
+}
+
+func (v *ValidationSuite) SetupSuite() {
+	config.SetupTestLogger()
+}
+
+func (v *ValidationSuite) SetupTest() {
+	v.repo = &test.MockRepository{}
+}
+
+// exists returns the upper levels of the node with the code
+func (v *ValidationSuite) exists(level, code string, path ...*domain.Region) {
+	query := provider.NewQuery(level).Filter("code", provider.Equal, code)
+	v.repo.On("Ancestors", mock.Anything, query).Return([]*domain.Ancestors{{ID: code, Path: path}}, nil)
+}
+
+// missing returns no node with the code
+func (v *ValidationSuite) missing(level, code string) {
+	query := provider.NewQuery(level).Filter("code", provider.Equal, code)
+	v.repo.On("Ancestors", mock.Anything, query).Return([]*domain.Ancestors{}, nil)
+}
+
+func (v *ValidationSuite) Test_Validate_Valid() {
+	province := &domain.Region{Code: "32", Level: domain.ProvinceNode}
+	regency := &domain.Region{Code: "32.04", Level: domain.RegencyNode}
+	district := &domain.Region{Code: "32.04.05", Level: domain.DistrictNode}
+
+	v.exists(domain.ProvinceNode, "32")
+	v.exists(domain.RegencyNode, "32.04", province)
+	v.exists(domain.VillageNode, "32.04.05.2001", province, regency, district)
+
+	validator := region.NewValidator(v.repo)
+	res, err := validator.Validate(context.Background(), map[string]string{
+		domain.ProvinceNode: "32",
+		domain.RegencyNode:  "32.04",
+		domain.VillageNode:  "32.04.05.2001",
+	})
+
+	assert.NoError(v.T(), err)
+	assert.True(v.T(), res.Valid)
+	assert.Len(v.T(), res.Regions, 3)
+	assert.Equal(v.T(), []*domain.RegionLink{
+		{Parent: domain.ProvinceNode, ParentCode: "32", Child: domain.RegencyNode, ChildCode: "32.04", Valid: true, ActualParentCode: "32"},
+		{Parent: domain.RegencyNode, ParentCode: "32.04", Child: domain.VillageNode, ChildCode: "32.04.05.2001", Valid: true, ActualParentCode: "32.04"},
+	}, res.Links)
+}
+
+func (v *ValidationSuite) Test_Validate_BrokenLink() {
+	province := &domain.Region{Code: "32", Level: domain.ProvinceNode}
+	city := &domain.Region{Code: "32.73", Level: domain.CityNode}
+
+	v.exists(domain.ProvinceNode, "32")
+	v.exists(domain.RegencyNode, "32.04", province)
+	v.exists(domain.DistrictNode, "32.73.02", province, city)
+
+	validator := region.NewValidator(v.repo)
+	res, err := validator.Validate(context.Background(), map[string]string{
+		domain.ProvinceNode: "32",
+		domain.RegencyNode:  "32.04",
+		domain.DistrictNode: "32.73.02",
+	})
+
+	assert.NoError(v.T(), err)
+	assert.False(v.T(), res.Valid)
+	assert.True(v.T(), res.Links[0].Valid)
+	assert.Equal(v.T(), &domain.RegionLink{
+		Parent:     domain.RegencyNode,
+		ParentCode: "32.04",
+		Child:      domain.DistrictNode,
+		ChildCode:  "32.73.02",
+	}, res.Links[1])
+}
+
+func (v *ValidationSuite) Test_Validate_WrongParent() {
+	province := &domain.Region{Code: "33", Level: domain.ProvinceNode}
+
+	v.exists(domain.ProvinceNode, "32")
+	v.exists(domain.CityNode, "33.74", province)
+
+	validator := region.NewValidator(v.repo)
+	res, err := validator.Validate(context.Background(), map[string]string{
+		domain.ProvinceNode: "32",
+		domain.CityNode:     "33.74",
+	})
+
+	assert.NoError(v.T(), err)
+	assert.False(v.T(), res.Valid)
+	assert.Equal(v.T(), "33", res.Links[0].ActualParentCode)
+	assert.False(v.T(), res.Links[0].Valid)
+}
+
+func (v *ValidationSuite) Test_Validate_NotExists() {
+	v.exists(domain.ProvinceNode, "32")
+	v.missing(domain.CityNode, "32.99")
+
+	validator := region.NewValidator(v.repo)
+	res, err := validator.Validate(context.Background(), map[string]string{
+		domain.ProvinceNode: "32",
+		domain.CityNode:     "32.99",
+	})
+
+	assert.NoError(v.T(), err)
+	assert.False(v.T(), res.Valid)
+	assert.False(v.T(), res.Regions[1].Exists)
+	assert.False(v.T(), res.Links[0].Valid)
+}
+
+func (v *ValidationSuite) Test_Validate_EmptyCode() {
+	province := &domain.Region{Code: "32", Level: domain.ProvinceNode}
+
+	v.exists(domain.ProvinceNode, "32")
+	v.exists(domain.DistrictNode, "32.73.02", province, &domain.Region{Code: "32.73", Level: domain.CityNode})
+
+	validator := region.NewValidator(v.repo)
+	res, err := validator.Validate(context.Background(), map[string]string{
+		domain.ProvinceNode: "32",
+		domain.RegencyNode:  "",
+		domain.DistrictNode: "32.73.02",
+	})
+
+	assert.NoError(v.T(), err)
+	assert.True(v.T(), res.Valid)
+	assert.Len(v.T(), res.Regions, 2)
+	assert.Equal(v.T(), []*domain.RegionLink{
+		{Parent: domain.ProvinceNode, ParentCode: "32", Child: domain.DistrictNode, ChildCode: "32.73.02", Valid: true, ActualParentCode: "32"},
+	}, res.Links)
+}
+
+func (v *ValidationSuite) Test_Validate_CityOrRegency() {
+	province := &domain.Region{Code: "32", Level: domain.ProvinceNode}
+	city := &domain.Region{Code: "32.73", Level: domain.CityNode}
+
+	v.exists(domain.ProvinceNode, "32")
+	v.exists(domain.CityNode, "32.73", province)
+	v.exists(domain.RegencyNode, "32.04", province)
+	v.exists(domain.DistrictNode, "32.73.02", province, city)
+
+	validator := region.NewValidator(v.repo)
+	res, err := validator.Validate(context.Background(), map[string]string{
+		domain.ProvinceNode: "32",
+		domain.CityNode:     "32.73",
+		domain.RegencyNode:  "32.04",
+		domain.DistrictNode: "32.73.02",
+	})
+
+	assert.NoError(v.T(), err)
+	assert.True(v.T(), res.Valid)
+	assert.Len(v.T(), res.Links, 3)
+	assert.Equal(v.T(), &domain.RegionLink{
+		Parent:           domain.CityNode,
+		ParentCode:       "32.73",
+		Child:            domain.DistrictNode,
+		ChildCode:        "32.73.02",
+		Valid:            true,
+		ActualParentCode: "32.73",
+	}, res.Links[2])
+}
+
+func (v *ValidationSuite) Test_Validate_Error() {
+	v.repo.On("Ancestors", mock.Anything, mock.Anything).Return(([]*domain.Ancestors)(nil), assert.AnError)
+
+	validator := region.NewValidator(v.repo)
+	res, err := validator.Validate(context.Background(), map[string]string{domain.CityNode: "32.73"})
+
+	assert.Nil(v.T(), res)
+	assert.Error(v.T(), err)
+}